@@ -37,6 +37,12 @@ ERROR HANDLING PATTERNS
 1. Check and Return    - if err != nil { return err }
 2. Check and Handle    - if err != nil { // handle error }
 3. Check and Wrap      - if err != nil { return fmt.Errorf("context: %w", err) }
+4. Collect and Join    - errs = append(errs, err); return errors.Join(errs...)
+
+MULTI-ERROR (Go 1.20+)
+----------------------
+errors.Join menggabungkan banyak error menjadi satu error berbentuk pohon.
+errors.Is dan errors.As tetap bisa menemukan error di dalam pohon tersebut.
 
 PANIC DAN RECOVER
 -----------------
@@ -44,17 +50,50 @@ PANIC DAN RECOVER
 - recover() - Menangkap panic, mencegah program crash
 - defer()   - Menunda eksekusi hingga fungsi selesai, berguna untuk cleanup
 
+Pola recover di SafeDivide bisa dibuat generic: Try[T] untuk fungsi biasa dan
+Go untuk goroutine. Panic diubah menjadi PanicError yang menyimpan nilai panic
+dan stack trace (runtime/debug.Stack) supaya lokasi bug tetap bisa dilacak.
+Perilaku guard diatur per panggilan lewat option, misal
+Try(fn, RepanicOnRuntimeError()), bukan lewat variabel global.
+
 KAPAN MENGGUNAKAN PANIC?
 ------------------------
 - Hanya untuk kondisi yang benar-benar fatal dan tidak bisa dipulihkan
 - Jangan gunakan panic untuk error biasa yang bisa ditangani
+
+KATALOG ERROR
+-------------
+Aplikasi besar biasanya memberi setiap jenis error sebuah KODE yang stabil:
+┌─────────────┬──────────────────┬──────────┬─────────────┐
+│ Kode        │ Error            │ Severity │ HTTP Status │
+├─────────────┼──────────────────┼──────────┼─────────────┤
+│ E-VAL-001   │ ValidationError  │ warning  │ 400         │
+│ E-VAL-002   │ umur negatif     │ warning  │ 400         │
+│ E-VAL-003   │ umur > 150       │ warning  │ 400         │
+│ E-NF-001    │ NotFoundError    │ info     │ 404         │
+│ E-DB-001    │ DatabaseError    │ critical │ 503         │
+│ E-INT-001   │ error lain       │ error    │ 500         │
+└─────────────┴──────────────────┴──────────┴─────────────┘
+Kode tidak berubah meski pesan diterjemahkan, sehingga CLI dan HTTP API
+bisa menampilkan error yang sama dalam bahasa Indonesia maupun Inggris.
+Setiap aturan validasi punya kode sendiri: teks bebas di field Message tidak
+pernah ikut masuk ke template, karena teks itu hanya tersedia dalam satu bahasa.
 */
 
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
 )
 
 // =============================================================================
@@ -66,6 +105,7 @@ import (
 type ValidationError struct {
 	Field   string // Field yang gagal validasi
 	Message string // Pesan error detail
+	Code    string // Kode aturan di katalog error, misal CodeAgeNegative
 }
 
 // Error() mengimplementasikan interface error
@@ -132,18 +172,23 @@ func FindUser(id string) (string, error) {
 	return name, nil
 }
 
+// MaxAge adalah umur maksimal yang diterima ValidateAge
+const MaxAge = 150
+
 // ValidateAge memvalidasi umur dengan custom error
 func ValidateAge(age int) error {
 	if age < 0 {
 		return ValidationError{
 			Field:   "age",
 			Message: "umur tidak boleh negatif",
+			Code:    CodeAgeNegative,
 		}
 	}
-	if age > 150 {
+	if age > MaxAge {
 		return ValidationError{
 			Field:   "age",
-			Message: "umur terlalu tinggi (maksimal 150)",
+			Message: fmt.Sprintf("umur terlalu tinggi (maksimal %d)", MaxAge),
+			Code:    CodeAgeTooHigh,
 		}
 	}
 	return nil
@@ -180,6 +225,305 @@ func GetUserFromDB(id string) (string, error) {
 	}
 }
 
+// =============================================================================
+// MULTI-ERROR DENGAN errors.Join (Go 1.20+)
+// =============================================================================
+
+// BatchInput berisi satu set input yang diproses oleh ProcessBatch
+type BatchInput struct {
+	A, B   int     // Operand untuk Divide
+	X      float64 // Input untuk Sqrt
+	Age    int     // Input untuk ValidateAge
+	UserID string  // Input untuk FindUser
+}
+
+// ProcessBatch menjalankan Divide, Sqrt, ValidateAge dan FindUser untuk setiap input
+// Berbeda dengan pola "berhenti di error pertama", semua error dikumpulkan
+// lalu digabung dengan errors.Join. Hasilnya nil jika tidak ada error sama sekali.
+func ProcessBatch(inputs []BatchInput) error {
+	var errs []error
+	for i, in := range inputs {
+		// Kumpulkan error per input agar pohon error mudah dibaca
+		var inputErrs []error
+
+		if _, err := Divide(in.A, in.B); err != nil {
+			inputErrs = append(inputErrs, fmt.Errorf("Divide(%d, %d): %w", in.A, in.B, err))
+		}
+		if _, err := Sqrt(in.X); err != nil {
+			inputErrs = append(inputErrs, fmt.Errorf("Sqrt(%g): %w", in.X, err))
+		}
+		if err := ValidateAge(in.Age); err != nil {
+			inputErrs = append(inputErrs, fmt.Errorf("ValidateAge(%d): %w", in.Age, err))
+		}
+		if _, err := FindUser(in.UserID); err != nil {
+			inputErrs = append(inputErrs, fmt.Errorf("FindUser(%q): %w", in.UserID, err))
+		}
+
+		// errors.Join mengembalikan nil jika semua argumennya nil
+		if err := errors.Join(inputErrs...); err != nil {
+			errs = append(errs, fmt.Errorf("input #%d: %w", i+1, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FormatErrorTree menampilkan error beserta seluruh error di dalamnya sebagai pohon
+// Mendukung dua bentuk Unwrap:
+// - Unwrap() error   : error yang membungkus satu error (fmt.Errorf dengan %w)
+// - Unwrap() []error : error yang membungkus banyak error (errors.Join)
+func FormatErrorTree(err error) string {
+	if err == nil {
+		return "<nil>\n"
+	}
+	var sb strings.Builder
+	writeErrorNode(&sb, err, "", "")
+	return sb.String()
+}
+
+// writeErrorNode menulis satu node pohon lalu memproses anak-anaknya secara rekursif
+// prefix dipakai untuk baris node ini, childPrefix untuk baris anak-anaknya
+func writeErrorNode(sb *strings.Builder, err error, prefix, childPrefix string) {
+	label := err.Error()
+	var children []error
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		children = e.Unwrap()
+		label = fmt.Sprintf("[%d error]", len(children))
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			children = []error{inner}
+			// Buang pesan anak dari pesan wrapper agar tidak tercetak dua kali
+			if trimmed, ok := strings.CutSuffix(label, ": "+inner.Error()); ok {
+				label = trimmed
+			}
+		}
+	}
+
+	fmt.Fprintf(sb, "%s%s (%T)\n", prefix, label, err)
+	for i, child := range children {
+		if i == len(children)-1 {
+			writeErrorNode(sb, child, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeErrorNode(sb, child, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+// =============================================================================
+// KATALOG ERROR (KODE, SEVERITY, HTTP STATUS, PESAN MULTI-BAHASA)
+// =============================================================================
+
+// Severity menunjukkan tingkat keparahan error
+type Severity int
+
+const (
+	SeverityInfo     Severity = iota // Informasi, biasanya karena input user
+	SeverityWarning                  // Perlu diperhatikan, tapi aplikasi tetap jalan
+	SeverityError                    // Operasi gagal
+	SeverityCritical                 // Komponen penting (misal database) bermasalah
+)
+
+var severityNames = []string{"info", "warning", "error", "critical"}
+
+// String mengimplementasikan fmt.Stringer
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// MarshalText dipakai encoding/json agar severity ditulis sebagai "warning", bukan 1
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText adalah kebalikan MarshalText
+func (s *Severity) UnmarshalText(text []byte) error {
+	for i, name := range severityNames {
+		if name == string(text) {
+			*s = Severity(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("severity tidak dikenal: %q", text)
+}
+
+// ErrorKind adalah satu entri di katalog error
+// Messages berisi template per bahasa, placeholder ditulis sebagai {nama}
+type ErrorKind struct {
+	Code       string            // Kode stabil, misal "E-VAL-001"
+	Severity   Severity          // Tingkat keparahan
+	HTTPStatus int               // Status HTTP untuk API
+	Messages   map[string]string // Template pesan per bahasa ("id", "en")
+}
+
+// Bahasa yang didukung katalog, DefaultLang dipakai jika bahasa tidak tersedia
+const (
+	LangID      = "id"
+	LangEN      = "en"
+	DefaultLang = LangID
+)
+
+// Kode aturan validasi, dipakai di field ValidationError.Code
+const (
+	CodeAgeNegative = "E-VAL-002"
+	CodeAgeTooHigh  = "E-VAL-003"
+)
+
+// Daftar jenis error di katalog
+var (
+	// KindValidation dipakai ValidationError tanpa Code yang terdaftar
+	KindValidation = ErrorKind{
+		Code:       "E-VAL-001",
+		Severity:   SeverityWarning,
+		HTTPStatus: http.StatusBadRequest,
+		Messages: map[string]string{
+			LangID: "validasi gagal pada field '{field}'",
+			LangEN: "validation failed on field '{field}'",
+		},
+	}
+	KindAgeNegative = ErrorKind{
+		Code:       CodeAgeNegative,
+		Severity:   SeverityWarning,
+		HTTPStatus: http.StatusBadRequest,
+		Messages: map[string]string{
+			LangID: "validasi gagal pada field '{field}': umur tidak boleh negatif",
+			LangEN: "validation failed on field '{field}': age must not be negative",
+		},
+	}
+	KindAgeTooHigh = ErrorKind{
+		Code:       CodeAgeTooHigh,
+		Severity:   SeverityWarning,
+		HTTPStatus: http.StatusBadRequest,
+		Messages: map[string]string{
+			LangID: fmt.Sprintf("validasi gagal pada field '{field}': umur terlalu tinggi (maksimal %d)", MaxAge),
+			LangEN: fmt.Sprintf("validation failed on field '{field}': age is too high (maximum %d)", MaxAge),
+		},
+	}
+	KindNotFound = ErrorKind{
+		Code:       "E-NF-001",
+		Severity:   SeverityInfo,
+		HTTPStatus: http.StatusNotFound,
+		Messages: map[string]string{
+			LangID: "{resource} dengan ID '{id}' tidak ditemukan",
+			LangEN: "{resource} with ID '{id}' not found",
+		},
+	}
+	KindDatabase = ErrorKind{
+		Code:       "E-DB-001",
+		Severity:   SeverityCritical,
+		HTTPStatus: http.StatusServiceUnavailable,
+		Messages: map[string]string{
+			LangID: "database error saat {op}",
+			LangEN: "database error during {op}",
+		},
+	}
+	KindInternal = ErrorKind{
+		Code:       "E-INT-001",
+		Severity:   SeverityError,
+		HTTPStatus: http.StatusInternalServerError,
+		Messages: map[string]string{
+			LangID: "terjadi kesalahan internal",
+			LangEN: "an internal error occurred",
+		},
+	}
+)
+
+// ErrorCatalog memetakan kode ke ErrorKind, berguna untuk lookup dari kode
+var ErrorCatalog = map[string]ErrorKind{
+	KindValidation.Code:  KindValidation,
+	KindAgeNegative.Code: KindAgeNegative,
+	KindAgeTooHigh.Code:  KindAgeTooHigh,
+	KindNotFound.Code:    KindNotFound,
+	KindDatabase.Code:    KindDatabase,
+	KindInternal.Code:    KindInternal,
+}
+
+// Render mengisi template pesan untuk bahasa lang dengan params.
+// strings.Replacer mengganti semua placeholder dalam SATU kali jalan, jadi
+// nilai yang kebetulan berisi "{...}" tidak ikut diganti dan hasilnya tidak
+// bergantung pada urutan iterasi map.
+func (k ErrorKind) Render(lang string, params map[string]string) string {
+	tmpl, ok := k.Messages[lang]
+	if !ok {
+		tmpl = k.Messages[DefaultLang]
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for key, value := range params {
+		pairs = append(pairs, "{"+key+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
+
+// CatalogedError diimplementasikan oleh error yang terdaftar di katalog
+type CatalogedError interface {
+	error
+	Kind() ErrorKind
+	Params() map[string]string
+}
+
+// Kind dan Params membuat ValidationError terdaftar di katalog.
+// Message tidak dikirim sebagai parameter: teksnya hanya berbahasa Indonesia,
+// sedangkan pesan per bahasa diambil dari entri katalog milik Code.
+func (e ValidationError) Kind() ErrorKind {
+	if kind, ok := ErrorCatalog[e.Code]; ok {
+		return kind
+	}
+	return KindValidation
+}
+func (e ValidationError) Params() map[string]string {
+	return map[string]string{"field": e.Field}
+}
+
+// Kind dan Params membuat NotFoundError terdaftar di katalog
+func (e NotFoundError) Kind() ErrorKind { return KindNotFound }
+func (e NotFoundError) Params() map[string]string {
+	return map[string]string{"resource": e.Resource, "id": e.ID}
+}
+
+// Kind dan Params membuat DatabaseError terdaftar di katalog
+func (e DatabaseError) Kind() ErrorKind { return KindDatabase }
+func (e DatabaseError) Params() map[string]string {
+	return map[string]string{"op": e.Op}
+}
+
+// ErrorResponse adalah bentuk error yang dikirim ke CLI maupun HTTP API
+type ErrorResponse struct {
+	Code     string            `json:"code"`
+	Severity Severity          `json:"severity"`
+	Status   int               `json:"status"`
+	Message  string            `json:"message"`
+	Details  map[string]string `json:"details,omitempty"`
+}
+
+// NewErrorResponse mencari CatalogedError di dalam err (termasuk yang di-wrap)
+// lalu menyusun ErrorResponse dalam bahasa lang.
+// Error yang tidak terdaftar di katalog dilaporkan sebagai KindInternal
+// tanpa detail, agar pesan internal tidak bocor ke user.
+func NewErrorResponse(err error, lang string) ErrorResponse {
+	kind, params := KindInternal, map[string]string(nil)
+
+	var cataloged CatalogedError
+	if errors.As(err, &cataloged) {
+		kind, params = cataloged.Kind(), cataloged.Params()
+	}
+
+	return ErrorResponse{
+		Code:     kind.Code,
+		Severity: kind.Severity,
+		Status:   kind.HTTPStatus,
+		Message:  kind.Render(lang, params),
+		Details:  params,
+	}
+}
+
+// String memformat ErrorResponse untuk output CLI
+func (r ErrorResponse) String() string {
+	return fmt.Sprintf("[%s] %s: %s", r.Code, r.Severity, r.Message)
+}
+
 // =============================================================================
 // PANIC DAN RECOVER
 // =============================================================================
@@ -206,31 +550,196 @@ func SafeDivide(a, b int) (result int, err error) {
 	return a / b, nil
 }
 
-// ProcessFile mensimulasikan pemrosesan file dengan defer
-// defer digunakan untuk cleanup resources
-func ProcessFile(filename string) error {
-	// Simulasi: buka file
-	fmt.Printf("Membuka file: %s\n", filename)
+// UncheckedDivide membagi TANPA pengecekan b == 0 dan tanpa recover.
+// Pembagian integer dengan nol di sini memicu runtime panic sungguhan.
+func UncheckedDivide(a, b int) int {
+	return a / b
+}
+
+// FileStats adalah ringkasan hasil ProcessFile
+type FileStats struct {
+	Lines       int    // Jumlah baris
+	Words       int    // Jumlah kata (dipisah whitespace)
+	SummaryPath string // Lokasi file ringkasan yang ditulis
+}
 
-	// defer akan dieksekusi saat fungsi return, meski ada error
-	// Urutan defer: LIFO (Last In First Out)
-	defer fmt.Println("Defer 1: Cleanup resource A")
-	defer fmt.Println("Defer 2: Cleanup resource B")
-	defer fmt.Println("Defer 3: Close file")
+// closeFile menutup file dari dalam defer.
+// Error dari Close diteruskan ke named return milik pemanggil (lewat pointer err),
+// tapi hanya jika belum ada error lain: error pertama biasanya lebih penting.
+func closeFile(f *os.File, err *error) {
+	fmt.Printf("Defer: close %s\n", filepath.Base(f.Name()))
+	if closeErr := f.Close(); closeErr != nil && *err == nil {
+		*err = fmt.Errorf("gagal menutup file: %w", closeErr)
+	}
+}
 
-	// Simulasi error
+// ErrEmptyFilename dikembalikan ProcessFile jika nama file kosong
+var ErrEmptyFilename = errors.New("filename tidak boleh kosong")
+
+// ProcessFile membaca file baris per baris, menghitung baris dan kata,
+// lalu menulis ringkasan ke file baru di direktori outDir milik pemanggil.
+// defer digunakan untuk cleanup resources: setiap file yang berhasil dibuka
+// langsung dijadwalkan untuk ditutup, urutannya LIFO (Last In First Out).
+// Named return err memungkinkan defer mengubah error yang dikembalikan.
+func ProcessFile(filename, outDir string) (stats FileStats, err error) {
 	if filename == "" {
-		return errors.New("filename tidak boleh kosong")
+		return stats, ErrEmptyFilename
 	}
 
+	fmt.Printf("Membuka file: %s\n", filepath.Base(filename))
+	input, err := os.Open(filename)
+	if err != nil {
+		// %w agar pemanggil bisa cek errors.Is(err, fs.ErrNotExist) / fs.ErrPermission
+		return stats, fmt.Errorf("gagal membuka file: %w", err)
+	}
+	defer closeFile(input, &err)
+
+	// bufio.Scanner membaca file secara streaming, tidak memuat seluruh isi ke memory
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		stats.Lines++
+		stats.Words += len(strings.Fields(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return stats, fmt.Errorf("gagal membaca %s: %w", filename, err)
+	}
+
+	output, err := os.CreateTemp(outDir, "ringkasan-*.txt")
+	if err != nil {
+		return stats, fmt.Errorf("gagal membuat file ringkasan: %w", err)
+	}
+	// Ringkasan yang gagal ditulis atau ditutup tidak boleh tertinggal.
+	// Defer ini didaftarkan sebelum closeFile sehingga berjalan SETELAH file ditutup.
+	defer func() {
+		if err != nil {
+			os.Remove(output.Name())
+			stats.SummaryPath = ""
+		}
+	}()
+	defer closeFile(output, &err) // Dieksekusi SEBELUM close input (LIFO)
+	stats.SummaryPath = output.Name()
+
 	fmt.Println("Memproses file...")
+	_, err = fmt.Fprintf(output, "file: %s\nbaris: %d\nkata: %d\n", filename, stats.Lines, stats.Words)
+	if err != nil {
+		return stats, fmt.Errorf("gagal menulis ringkasan: %w", err)
+	}
+	return stats, nil
+}
+
+// =============================================================================
+// GENERIC PANIC GUARD (Try DAN Go)
+// =============================================================================
+
+// PanicError adalah error hasil konversi panic
+type PanicError struct {
+	Value any    // Nilai yang dipanic (argumen panic())
+	Stack []byte // Stack trace saat panic terjadi
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("panic recovered: %v", e.Value)
+}
+
+// Unwrap mengembalikan nilai panic jika berupa error (misal runtime.Error)
+// sehingga errors.As(err, &runtimeErr) tetap bekerja
+func (e PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
 	return nil
 }
 
+// guardConfig menyimpan pengaturan satu panggilan Try atau Go
+type guardConfig struct {
+	repanicOnRuntimeError bool
+}
+
+// GuardOption mengubah perilaku Try dan Go untuk satu panggilan saja.
+// Karena tidak ada state global, dua goroutine bisa memakai pengaturan
+// berbeda tanpa data race.
+type GuardOption func(*guardConfig)
+
+// RepanicOnRuntimeError membuat Try atau Go melempar ulang panic bertipe
+// runtime.Error (nil pointer, index out of range, nil map, dll).
+// Panic seperti ini biasanya BUG yang sebaiknya tidak disembunyikan.
+func RepanicOnRuntimeError() GuardOption {
+	return func(c *guardConfig) { c.repanicOnRuntimeError = true }
+}
+
+func newGuardConfig(opts []GuardOption) guardConfig {
+	var cfg guardConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// recoverAsError dipanggil langsung oleh defer di Try dan Go
+// recover() hanya bekerja jika dipanggil langsung dari fungsi yang di-defer,
+// karena itu nilai r diterima sebagai parameter
+func recoverAsError(r any, cfg guardConfig) error {
+	if _, isRuntime := r.(runtime.Error); isRuntime && cfg.repanicOnRuntimeError {
+		panic(r)
+	}
+	return PanicError{Value: r, Stack: debug.Stack()}
+}
+
+// Try menjalankan fn dan mengubah panic menjadi error
+// Generalisasi dari SafeDivide untuk fungsi dengan return type apa pun
+func Try[T any](fn func() T, opts ...GuardOption) (result T, err error) {
+	cfg := newGuardConfig(opts)
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverAsError(r, cfg)
+		}
+	}()
+	return fn(), nil
+}
+
+// Go menjalankan fn di goroutine baru dengan perlindungan panic
+// Panic di goroutine TIDAK bisa di-recover dari goroutine lain, sehingga
+// recover harus dipasang di dalam goroutine itu sendiri.
+// Channel yang dikembalikan menerima satu nilai (nil atau PanicError) lalu ditutup.
+func Go(fn func(), opts ...GuardOption) <-chan error {
+	cfg := newGuardConfig(opts)
+	done := make(chan error, 1)
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				done <- recoverAsError(r, cfg)
+			}
+		}()
+		fn()
+		done <- nil
+	}()
+	return done
+}
+
+// panicSite mencari lokasi panic di stack trace: frame pertama setelah panic(...)
+// yang bukan milik package runtime. Setiap frame terdiri dari 2 baris:
+// nama fungsi lalu file:line.
+func panicSite(stack []byte) string {
+	lines := strings.Split(string(stack), "\n")
+	afterPanic := false
+	for i := 0; i+1 < len(lines); i++ {
+		if strings.HasPrefix(lines[i], "panic(") {
+			afterPanic = true
+			continue
+		}
+		if afterPanic && !strings.HasPrefix(lines[i], "runtime.") && !strings.HasPrefix(lines[i], "\t") {
+			return lines[i] + "\n" + lines[i+1]
+		}
+	}
+	return "(lokasi tidak ditemukan)"
+}
+
 func main() {
 	fmt.Println("================================================================================")
 	fmt.Println("ERROR HANDLING")
-	fmt.Println("================================================================================\n")
+	fmt.Println("================================================================================")
+	fmt.Println()
 
 	// =============================================================================
 	// 1. ERROR HANDLING DASAR
@@ -319,15 +828,55 @@ func main() {
 	// Berguna untuk cleanup: close file, close database, unlock mutex, dll
 	fmt.Println("\n--- 5. defer Statement ---")
 
-	err = ProcessFile("data.txt")
+	// Siapkan file contoh di direktori sementara, hapus semuanya saat main selesai
+	tempDir, err := os.MkdirTemp("", "pelajaran11-*")
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	dataPath := filepath.Join(tempDir, "data.txt")
+	content := "Go memperlakukan error sebagai value\ndefer menjalankan cleanup\nselesai\n"
+	if err := os.WriteFile(dataPath, []byte(content), 0o644); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	// Ringkasan ditulis ke tempDir juga, sehingga ikut terhapus oleh RemoveAll
+	stats, err := ProcessFile(dataPath, tempDir)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	} else {
+		fmt.Printf("Hasil: %d baris, %d kata\n", stats.Lines, stats.Words)
+		summary, _ := os.ReadFile(stats.SummaryPath)
+		fmt.Printf("Isi ringkasan:\n%s", summary)
 	}
 
 	fmt.Println("\nPanggil ProcessFile dengan error:")
-	err = ProcessFile("")
+	_, err = ProcessFile("", tempDir)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		fmt.Printf("errors.Is(err, ErrEmptyFilename): %v\n", errors.Is(err, ErrEmptyFilename))
+	}
+
+	_, err = ProcessFile(filepath.Join(tempDir, "tidak-ada.txt"), tempDir)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
+		fmt.Printf("errors.Is(err, fs.ErrNotExist): %v\n", errors.Is(err, fs.ErrNotExist))
+	}
+
+	// File tanpa izin baca. User root mengabaikan permission file, jadi
+	// contoh ini hanya bermakna untuk user biasa.
+	if os.Geteuid() == 0 {
+		fmt.Println("Contoh fs.ErrPermission dilewati: root tetap bisa membaca file mode 0o000")
+	} else {
+		secretPath := filepath.Join(tempDir, "rahasia.txt")
+		if err := os.WriteFile(secretPath, []byte("rahasia\n"), 0o000); err == nil {
+			_, err = ProcessFile(secretPath, tempDir)
+			fmt.Printf("Error: %v\n", err)
+			fmt.Printf("errors.Is(err, fs.ErrPermission): %v\n", errors.Is(err, fs.ErrPermission))
+		}
 	}
 	// Perhatikan: defer tetap dieksekusi meski ada error!
 
@@ -346,6 +895,59 @@ func main() {
 		fmt.Printf("Hasil: %d\n", result)
 	}
 
+	// Try[T]: recover generic untuk fungsi apa pun
+	fmt.Println("\nTry[T] - generalisasi SafeDivide:")
+	// UncheckedDivide tidak punya recover sendiri: Try yang melindunginya
+	divResult, err := Try(func() int { return UncheckedDivide(10, 2) })
+	fmt.Printf("Try(UncheckedDivide(10, 2)) = %d, err = %v\n", divResult, err)
+
+	zero := 0
+	divResult, err = Try(func() int { return UncheckedDivide(10, zero) }) // runtime panic
+	fmt.Printf("Try(UncheckedDivide(10, 0)) = %d, err = %v\n", divResult, err)
+
+	// Nil map write (pelajaran 07): map harus di-make dulu
+	_, err = Try(func() bool {
+		var nilMap map[string]int
+		nilMap["kunci"] = 1
+		return true
+	})
+	fmt.Printf("Try(nil map write): %v\n", err)
+
+	// Nil pointer dereference (pelajaran 10)
+	_, err = Try(func() int {
+		var nilPtr *int
+		return *nilPtr
+	})
+	fmt.Printf("Try(nil pointer deref): %v\n", err)
+
+	// PanicError menyimpan stack trace dan membungkus runtime.Error
+	var panicErr PanicError
+	if errors.As(err, &panicErr) {
+		fmt.Println("Lokasi panic dari stack trace:")
+		fmt.Println(panicSite(panicErr.Stack))
+	}
+	var runtimeErr runtime.Error
+	fmt.Printf("errors.As(err, runtime.Error): %v\n", errors.As(err, &runtimeErr))
+
+	// Go: panic di goroutine diubah menjadi error lewat channel
+	fmt.Println("\nGo - goroutine guard:")
+	fmt.Printf("Goroutine normal: %v\n", <-Go(func() {}))
+	fmt.Printf("Goroutine panic : %v\n", <-Go(func() { panic("goroutine gagal") }))
+
+	// RepanicOnRuntimeError(): bug (runtime.Error) tidak disembunyikan.
+	// Option berlaku hanya untuk panggilan yang menerimanya.
+	fmt.Println("\nTry(fn, RepanicOnRuntimeError()):")
+	func() {
+		defer func() {
+			fmt.Printf("Panic dilempar ulang: %v\n", recover())
+		}()
+		_, _ = Try(func() int { return UncheckedDivide(10, zero) }, RepanicOnRuntimeError())
+	}()
+	_, err = Try(func() int { panic("panic biasa tetap jadi error") }, RepanicOnRuntimeError())
+	fmt.Printf("Panic non-runtime: %v\n", err)
+	_, err = Try(func() int { return UncheckedDivide(10, zero) })
+	fmt.Printf("Tanpa option      : %v\n", err)
+
 	// =============================================================================
 	// 7. MULTIPLE ERROR CHECKING
 	// =============================================================================
@@ -369,6 +971,43 @@ func main() {
 		fmt.Printf("Chain result: %f\n", sqrt)
 	}()
 
+	// Cara 2: Jalankan semua operasi, kumpulkan SEMUA error dengan errors.Join
+	// Cocok untuk batch processing: user ingin tahu semua input yang salah sekaligus
+	fmt.Println("\nBatch processing dengan errors.Join:")
+	inputs := []BatchInput{
+		{A: 10, B: 2, X: 16, Age: 25, UserID: "001"}, // Semua sukses
+		{A: 10, B: 0, X: -4, Age: 30, UserID: "002"}, // Divide dan Sqrt gagal
+		{A: 8, B: 4, X: 9, Age: -5, UserID: "999"},   // ValidateAge dan FindUser gagal
+		{A: 1, B: 0, X: 1, Age: 200, UserID: "404"},  // Divide, ValidateAge, FindUser gagal
+	}
+
+	batchErr := ProcessBatch(inputs)
+	if batchErr != nil {
+		// Error() dari errors.Join menggabungkan pesan dengan newline
+		fmt.Printf("Error gabungan:\n%v\n", batchErr)
+
+		fmt.Println("\nPohon error:")
+		fmt.Print(FormatErrorTree(batchErr))
+	}
+
+	// errors.Is dan errors.As menelusuri SELURUH pohon, bukan hanya cabang pertama
+	fmt.Println("\nInspeksi pohon error:")
+
+	// errors.Is membandingkan dengan ==, NotFoundError comparable sehingga bisa dipakai
+	target := NotFoundError{Resource: "User", ID: "404"}
+	fmt.Printf("errors.Is(err, %v): %v\n", target, errors.Is(batchErr, target))
+
+	// errors.As mengambil error PERTAMA yang cocok dengan tipe target
+	var notFound NotFoundError
+	if errors.As(batchErr, &notFound) {
+		fmt.Printf("errors.As NotFoundError  : resource=%s, id=%s\n", notFound.Resource, notFound.ID)
+	}
+
+	var validationErr ValidationError
+	if errors.As(batchErr, &validationErr) {
+		fmt.Printf("errors.As ValidationError: field=%s, pesan=%s\n", validationErr.Field, validationErr.Message)
+	}
+
 	// =============================================================================
 	// 8. BEST PRACTICES
 	// =============================================================================
@@ -380,6 +1019,7 @@ func main() {
 	fmt.Println("   - Gunakan custom error untuk konteks spesifik")
 	fmt.Println("   - Wrap error dengan konteks tambahan")
 	fmt.Println("   - Gunakan defer untuk cleanup resources")
+	fmt.Println("   - Gunakan errors.Join untuk mengumpulkan banyak error")
 
 	fmt.Println("\n❌ DON'T:")
 	fmt.Println("   - Abaikan error dengan _ (kecuali memang sengaja)")
@@ -424,6 +1064,44 @@ func main() {
 		fmt.Printf("Wrapped error: %v\n", err)
 	}
 
+	// =============================================================================
+	// 10. KATALOG ERROR
+	// =============================================================================
+	// Satu error, dua tampilan: baris teks untuk CLI dan JSON untuk HTTP API
+	fmt.Println("\n--- 10. Katalog Error ---")
+
+	_, dbErr := GetUserFromDB("001")
+	catalogErrs := []error{
+		ValidateAge(-5),
+		fmt.Errorf("handler profil: %w", NotFoundError{Resource: "User", ID: "999"}),
+		dbErr,
+		errors.New("nil map"), // Tidak terdaftar di katalog
+	}
+
+	fmt.Println("Output CLI:")
+	for _, e := range catalogErrs {
+		fmt.Printf("  id: %v\n", NewErrorResponse(e, LangID))
+		fmt.Printf("  en: %v\n", NewErrorResponse(e, LangEN))
+	}
+
+	fmt.Println("\nOutput HTTP API (JSON):")
+	resp := NewErrorResponse(catalogErrs[1], LangEN)
+	body, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	} else {
+		fmt.Printf("HTTP %d\n%s\n", resp.Status, body)
+	}
+
+	// Client bisa decode kembali lalu mencari kode di katalog
+	var decoded ErrorResponse
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	} else if kind, ok := ErrorCatalog[decoded.Code]; ok {
+		fmt.Printf("Decode: kode %s, severity %s, pesan id: %s\n",
+			kind.Code, decoded.Severity, kind.Render(LangID, decoded.Details))
+	}
+
 	fmt.Println("\n================================================================================")
 	fmt.Println("SELESAI - Error handling di Go: explicit, simple, dan full control")
 	fmt.Println("================================================================================")
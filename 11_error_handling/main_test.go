@@ -0,0 +1,420 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestProcessFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string      // Isi file input; diabaikan jika file tidak dibuat
+		mode       fs.FileMode // 0 berarti file input tidak dibuat
+		filename   string      // Nama file di t.TempDir(); "" untuk kasus nama kosong
+		wantErr    error
+		wantLines  int
+		wantWords  int
+		skipAsRoot bool
+	}{
+		{
+			name:      "berhasil",
+			content:   "satu dua\ntiga\n",
+			mode:      0o644,
+			filename:  "data.txt",
+			wantLines: 2,
+			wantWords: 3,
+		},
+		{
+			name:     "file tidak ada",
+			filename: "tidak-ada.txt",
+			wantErr:  fs.ErrNotExist,
+		},
+		{
+			name:       "tanpa izin baca",
+			content:    "rahasia\n",
+			mode:       0o000,
+			filename:   "rahasia.txt",
+			wantErr:    fs.ErrPermission,
+			skipAsRoot: true, // root mengabaikan permission file
+		},
+		{
+			name:    "nama file kosong",
+			wantErr: ErrEmptyFilename,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.skipAsRoot && os.Geteuid() == 0 {
+				t.Skip("dijalankan sebagai root: permission file tidak berlaku")
+			}
+
+			inDir, outDir := t.TempDir(), t.TempDir()
+			path := ""
+			if tt.filename != "" {
+				path = filepath.Join(inDir, tt.filename)
+			}
+			if tt.mode != 0 || tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), tt.mode); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stats, err := ProcessFile(path, outDir)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ProcessFile(%q) error = %v, want %v", tt.filename, err, tt.wantErr)
+			}
+
+			entries, _ := os.ReadDir(outDir)
+			if tt.wantErr != nil {
+				if len(entries) != 0 {
+					t.Errorf("outDir berisi %d file setelah error, want 0", len(entries))
+				}
+				return
+			}
+			if stats.Lines != tt.wantLines || stats.Words != tt.wantWords {
+				t.Errorf("stats = %d baris, %d kata, want %d baris, %d kata",
+					stats.Lines, stats.Words, tt.wantLines, tt.wantWords)
+			}
+			if filepath.Dir(stats.SummaryPath) != outDir {
+				t.Errorf("SummaryPath = %s, want di dalam %s", stats.SummaryPath, outDir)
+			}
+			if _, err := os.Stat(stats.SummaryPath); err != nil {
+				t.Errorf("file ringkasan tidak ada: %v", err)
+			}
+		})
+	}
+}
+
+// Jika ringkasan gagal dibuat, tidak ada file yang tertinggal dan
+// SummaryPath tetap kosong.
+func TestProcessFileOutDirMissing(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.txt")
+	if err := os.WriteFile(path, []byte("halo\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := ProcessFile(path, filepath.Join(dir, "tidak-ada"))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("error = %v, want fs.ErrNotExist", err)
+	}
+	if stats.SummaryPath != "" {
+		t.Errorf("SummaryPath = %q, want kosong", stats.SummaryPath)
+	}
+}
+
+func TestProcessBatch(t *testing.T) {
+	valid := BatchInput{A: 10, B: 2, X: 16, Age: 25, UserID: "001"}
+	if err := ProcessBatch([]BatchInput{valid, valid}); err != nil {
+		t.Errorf("ProcessBatch(semua valid) = %v, want nil", err)
+	}
+	if err := ProcessBatch(nil); err != nil {
+		t.Errorf("ProcessBatch(nil) = %v, want nil", err)
+	}
+
+	err := ProcessBatch([]BatchInput{
+		valid,
+		{A: 1, B: 0, X: 1, Age: -5, UserID: "999"}, // Divide, ValidateAge, FindUser gagal
+		valid,
+		{A: 1, B: 1, X: -1, Age: 200, UserID: "001"}, // Sqrt, ValidateAge gagal
+	})
+	if err == nil {
+		t.Fatal("ProcessBatch = nil, want error")
+	}
+
+	// Satu cabang per input yang gagal, bernomor sesuai posisinya
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("error bertipe %T, want hasil errors.Join", err)
+	}
+	branches := joined.Unwrap()
+	if len(branches) != 2 {
+		t.Fatalf("jumlah cabang = %d, want 2", len(branches))
+	}
+	for i, want := range []string{"input #2: ", "input #4: "} {
+		if got := branches[i].Error(); !strings.HasPrefix(got, want) {
+			t.Errorf("cabang %d = %q, want awalan %q", i, got, want)
+		}
+	}
+
+	// errors.As menemukan error di cabang mana pun
+	var notFound NotFoundError
+	if !errors.As(err, &notFound) || notFound.ID != "999" {
+		t.Errorf("errors.As NotFoundError = %+v, want ID 999", notFound)
+	}
+	var validation ValidationError
+	if !errors.As(branches[1], &validation) || validation.Code != CodeAgeTooHigh {
+		t.Errorf("errors.As ValidationError di input #4 = %+v, want Code %s", validation, CodeAgeTooHigh)
+	}
+}
+
+func TestFormatErrorTree(t *testing.T) {
+	errDasar := errors.New("koneksi putus")
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, "<nil>\n"},
+		{"error tunggal", errDasar, "koneksi putus (*errors.errorString)\n"},
+		{
+			name: "wrap satu tingkat",
+			err:  fmt.Errorf("simpan: %w", errDasar),
+			want: "simpan (*fmt.wrapError)\n" +
+				"└── koneksi putus (*errors.errorString)\n",
+		},
+		{
+			name: "join bersarang",
+			err: errors.Join(
+				fmt.Errorf("input #1: %w", errors.Join(errDasar, NotFoundError{Resource: "User", ID: "9"})),
+				DatabaseError{Op: "insert", Err: errDasar},
+			),
+			want: "[2 error] (*errors.joinError)\n" +
+				"├── input #1 (*fmt.wrapError)\n" +
+				"│   └── [2 error] (*errors.joinError)\n" +
+				"│       ├── koneksi putus (*errors.errorString)\n" +
+				"│       └── User dengan ID '9' tidak ditemukan (main.NotFoundError)\n" +
+				"└── database error saat insert (main.DatabaseError)\n" +
+				"    └── koneksi putus (*errors.errorString)\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatErrorTree(tt.err); got != tt.want {
+				t.Errorf("FormatErrorTree =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		lang        string
+		wantCode    string
+		wantStatus  int
+		wantMessage string
+		wantDetails map[string]string
+	}{
+		{
+			name:        "umur negatif",
+			err:         ValidateAge(-1),
+			lang:        LangID,
+			wantCode:    CodeAgeNegative,
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "validasi gagal pada field 'age': umur tidak boleh negatif",
+			wantDetails: map[string]string{"field": "age"},
+		},
+		{
+			name:        "umur terlalu tinggi dalam bahasa Inggris",
+			err:         ValidateAge(MaxAge + 1),
+			lang:        LangEN,
+			wantCode:    CodeAgeTooHigh,
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "validation failed on field 'age': age is too high (maximum 150)",
+			wantDetails: map[string]string{"field": "age"},
+		},
+		{
+			name:        "Code tidak terdaftar memakai KindValidation",
+			err:         ValidationError{Field: "email", Message: "format salah", Code: "E-TIDAK-ADA"},
+			lang:        LangEN,
+			wantCode:    KindValidation.Code,
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "validation failed on field 'email'",
+			wantDetails: map[string]string{"field": "email"},
+		},
+		{
+			name:        "NotFoundError di dalam wrap",
+			err:         fmt.Errorf("handler: %w", NotFoundError{Resource: "User", ID: "404"}),
+			lang:        LangEN,
+			wantCode:    KindNotFound.Code,
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "User with ID '404' not found",
+			wantDetails: map[string]string{"resource": "User", "id": "404"},
+		},
+		{
+			name:        "bahasa tidak dikenal memakai DefaultLang",
+			err:         DatabaseError{Op: "insert", Err: errors.New("timeout")},
+			lang:        "fr",
+			wantCode:    KindDatabase.Code,
+			wantStatus:  http.StatusServiceUnavailable,
+			wantMessage: "database error saat insert",
+			wantDetails: map[string]string{"op": "insert"},
+		},
+		{
+			name:        "error biasa tidak membocorkan pesan internal",
+			err:         errors.New("password db: rahasia"),
+			lang:        LangID,
+			wantCode:    KindInternal.Code,
+			wantStatus:  http.StatusInternalServerError,
+			wantMessage: "terjadi kesalahan internal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewErrorResponse(tt.err, tt.lang)
+			if got.Code != tt.wantCode || got.Status != tt.wantStatus || got.Message != tt.wantMessage {
+				t.Errorf("NewErrorResponse = %+v, want code %s status %d message %q",
+					got, tt.wantCode, tt.wantStatus, tt.wantMessage)
+			}
+			if !maps.Equal(got.Details, tt.wantDetails) {
+				t.Errorf("Details = %v, want %v", got.Details, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestErrorResponseJSON(t *testing.T) {
+	resp := NewErrorResponse(NotFoundError{Resource: "User", ID: "404"}, LangID)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Severity ditulis sebagai nama lewat MarshalText, bukan angka
+	if !strings.Contains(string(data), `"severity":"info"`) {
+		t.Errorf("JSON = %s, want severity \"info\"", data)
+	}
+
+	var kembali ErrorResponse
+	if err := json.Unmarshal(data, &kembali); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", data, err)
+	}
+	if !reflect.DeepEqual(kembali, resp) {
+		t.Errorf("round-trip = %+v, want %+v", kembali, resp)
+	}
+
+	// Error internal tidak punya details: field dilewati (omitempty)
+	data, _ = json.Marshal(NewErrorResponse(errors.New("x"), LangID))
+	if strings.Contains(string(data), "details") {
+		t.Errorf("JSON error internal = %s, want tanpa details", data)
+	}
+
+	if err := json.Unmarshal([]byte(`{"severity":"fatal"}`), &kembali); err == nil {
+		t.Error("Unmarshal severity \"fatal\" = nil, want error")
+	}
+}
+
+func TestErrorCatalog(t *testing.T) {
+	for code, kind := range ErrorCatalog {
+		if kind.Code != code {
+			t.Errorf("ErrorCatalog[%s].Code = %s", code, kind.Code)
+		}
+		if kind.HTTPStatus < 400 || kind.HTTPStatus > 599 {
+			t.Errorf("%s: HTTPStatus = %d, want 4xx atau 5xx", code, kind.HTTPStatus)
+		}
+		for _, lang := range []string{LangID, LangEN} {
+			if kind.Messages[lang] == "" {
+				t.Errorf("%s: pesan bahasa %s kosong", code, lang)
+			}
+		}
+	}
+}
+
+// tangkapPanic menjalankan fn dan mengembalikan nilai panic-nya (nil jika tidak panic)
+func tangkapPanic(fn func()) (r any) {
+	defer func() { r = recover() }()
+	fn()
+	return nil
+}
+
+func TestTry(t *testing.T) {
+	got, err := Try(func() int { return UncheckedDivide(10, 2) })
+	if got != 5 || err != nil {
+		t.Errorf("Try(10/2) = %d, %v, want 5, nil", got, err)
+	}
+
+	got, err = Try(func() int { panic("gagal") })
+	var pe PanicError
+	if !errors.As(err, &pe) || pe.Value != "gagal" || len(pe.Stack) == 0 {
+		t.Fatalf("Try(panic) error = %#v, want PanicError dengan Value \"gagal\" dan stack", err)
+	}
+	if got != 0 {
+		t.Errorf("Try(panic) hasil = %d, want zero value 0", got)
+	}
+
+	// Tanpa opsi, runtime error juga menjadi error dan tetap bisa diinspeksi
+	zero := 0
+	_, err = Try(func() int { return UncheckedDivide(10, zero) })
+	var re runtime.Error
+	if !errors.As(err, &re) {
+		t.Errorf("Try(10/0) error = %v, want membungkus runtime.Error", err)
+	}
+}
+
+func TestTryRepanicOnRuntimeError(t *testing.T) {
+	zero := 0
+	r := tangkapPanic(func() {
+		_, _ = Try(func() int { return UncheckedDivide(10, zero) }, RepanicOnRuntimeError())
+	})
+	if _, ok := r.(runtime.Error); !ok {
+		t.Errorf("panic = %v (%T), want runtime.Error dilempar ulang", r, r)
+	}
+
+	// Panic yang bukan runtime.Error tetap menjadi error
+	var err error
+	r = tangkapPanic(func() {
+		_, err = Try(func() int { panic("bukan bug runtime") }, RepanicOnRuntimeError())
+	})
+	if r != nil || err == nil {
+		t.Errorf("Try(panic biasa, Repanic) = panic %v, err %v, want tanpa panic dan error", r, err)
+	}
+}
+
+// Opsi berlaku per panggilan: Try dengan dan tanpa opsi bisa berjalan
+// bersamaan tanpa saling memengaruhi (jalankan dengan -race)
+func TestTryOpsiPerPanggilan(t *testing.T) {
+	zero := 0
+	denganOpsi, tanpaOpsi := make(chan any), make(chan error)
+	for range 10 {
+		go func() {
+			denganOpsi <- tangkapPanic(func() {
+				_, _ = Try(func() int { return UncheckedDivide(1, zero) }, RepanicOnRuntimeError())
+			})
+		}()
+		go func() {
+			_, err := Try(func() int { return UncheckedDivide(1, zero) })
+			tanpaOpsi <- err
+		}()
+	}
+	for range 10 {
+		if r := <-denganOpsi; r == nil {
+			t.Error("Try dengan RepanicOnRuntimeError tidak panic")
+		}
+		if err := <-tanpaOpsi; err == nil {
+			t.Error("Try tanpa opsi tidak mengembalikan error")
+		}
+	}
+}
+
+func TestGo(t *testing.T) {
+	done := Go(func() {})
+	if err := <-done; err != nil {
+		t.Errorf("Go(normal) = %v, want nil", err)
+	}
+	if _, ok := <-done; ok {
+		t.Error("channel Go tidak ditutup setelah satu nilai")
+	}
+
+	var pe PanicError
+	if err := <-Go(func() { panic("goroutine gagal") }); !errors.As(err, &pe) || pe.Value != "goroutine gagal" {
+		t.Errorf("Go(panic) = %v, want PanicError \"goroutine gagal\"", err)
+	}
+
+	var m map[string]int
+	var re runtime.Error
+	if err := <-Go(func() { m["x"] = 1 }); !errors.As(err, &re) {
+		t.Errorf("Go(nil map write) = %v, want membungkus runtime.Error", err)
+	}
+}
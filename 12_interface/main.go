@@ -0,0 +1,493 @@
+/*
+================================================================================
+PELAJARAN 12: INTERFACE
+================================================================================
+
+APA ITU INTERFACE?
+------------------
+Interface adalah kumpulan METHOD SIGNATURE (tanpa implementasi).
+Interface mendefinisikan "apa yang bisa dilakukan", bukan "bagaimana caranya".
+
+Analogi: colokan listrik. Semua alat yang punya steker yang cocok bisa dicolok,
+tidak peduli alat itu kipas, TV, atau charger.
+
+ANATOMI INTERFACE:
+------------------
+type NamaInterface interface {
+    Method1() TipeReturn
+    Method2(param Tipe) TipeReturn
+}
+
+IMPLEMENTASI IMPLISIT
+---------------------
+Go TIDAK punya kata kunci "implements" seperti Java.
+Sebuah tipe otomatis memenuhi interface jika punya SEMUA method-nya.
+
+Interface yang sudah kita pakai tanpa sadar (pelajaran 11):
+type error interface {
+    Error() string
+}
+
+METHOD SET: VALUE vs POINTER RECEIVER
+-------------------------------------
+┌──────────────┬───────────────────────────┬───────────────────────────┐
+│ Tipe         │ Method value receiver     │ Method pointer receiver   │
+│              │ func (p Person) ...       │ func (p *Person) ...      │
+├──────────────┼───────────────────────────┼───────────────────────────┤
+│ Person       │ ✅ termasuk               │ ❌ tidak termasuk         │
+│ *Person      │ ✅ termasuk               │ ✅ termasuk               │
+└──────────────┴───────────────────────────┴───────────────────────────┘
+Artinya: jika interface butuh Birthday() (pointer receiver), yang memenuhi
+interface hanya *Person, bukan Person.
+
+TYPE ASSERTION DAN TYPE SWITCH
+------------------------------
+- value.(Tipe)          - Ambil nilai konkret (panic jika salah tipe)
+- v, ok := value.(Tipe) - Versi aman dengan comma-ok
+- switch v := value.(type) { case Tipe1: ... } - Cek banyak tipe sekaligus
+
+JEBAKAN NIL INTERFACE
+---------------------
+Interface bernilai nil HANYA jika tipe DAN nilainya nil.
+Interface yang berisi pointer nil (*T)(nil) TIDAK sama dengan nil!
+
+LATIHAN
+-------
+1. Buat struct Car (Merk, Model, Tahun) yang memenuhi Describer.
+2. Tambahkan case Car di fungsi jelaskan (type switch).
+3. Buat interface Diskon { HargaDiskon(persen float64) float64 } untuk Product.
+4. Perbaiki cariKaryawan agar tidak terkena jebakan nil interface.
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+Bandingkan output program dengan file expected_output.txt:
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =============================================================================
+// DEFINISI INTERFACE
+// =============================================================================
+
+// Describer adalah interface untuk tipe yang bisa mendeskripsikan dirinya
+type Describer interface {
+	Describe() string
+}
+
+// Pengenal dipenuhi oleh tipe yang punya method Perkenalan()
+type Pengenal interface {
+	Perkenalan()
+}
+
+// BertambahUmur dipenuhi oleh tipe yang punya method Birthday()
+type BertambahUmur interface {
+	Birthday()
+}
+
+// DescribeStringer adalah EMBEDDED INTERFACE: gabungan Describer dan fmt.Stringer
+// Tipe harus punya Describe() DAN String() untuk memenuhi interface ini
+type DescribeStringer interface {
+	Describer
+	fmt.Stringer
+}
+
+// =============================================================================
+// STRUCT DARI PELAJARAN 09
+// =============================================================================
+
+// Person merepresentasikan data seseorang
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+}
+
+// Product merepresentasikan barang dagangan
+type Product struct {
+	Nama     string
+	Harga    float64
+	Stok     int
+	Tersedia bool
+}
+
+// Address merepresentasikan alamat lengkap
+type Address struct {
+	Jalan   string
+	Kota    string
+	KodePos string
+}
+
+// Employee merepresentasikan karyawan dengan alamat (nested struct)
+type Employee struct {
+	Nama    string
+	Umur    int
+	Address Address
+}
+
+// =============================================================================
+// IMPLEMENTASI INTERFACE (IMPLISIT)
+// =============================================================================
+// Tidak ada "Person implements Describer", cukup definisikan method-nya
+
+// Describe membuat Person memenuhi Describer
+func (p Person) Describe() string {
+	return fmt.Sprintf("%s (%d tahun) dari %s", p.Nama, p.Umur, p.Alamat)
+}
+
+// String membuat Person memenuhi fmt.Stringer
+// fmt.Println dan %v otomatis memanggil String() jika ada
+func (p Person) String() string {
+	return "Person<" + p.Nama + ">"
+}
+
+// Perkenalan memakai VALUE receiver: dimiliki oleh Person dan *Person
+func (p Person) Perkenalan() {
+	fmt.Printf("Halo, nama saya %s, umur %d tahun\n", p.Nama, p.Umur)
+}
+
+// Birthday memakai POINTER receiver: hanya dimiliki oleh *Person
+func (p *Person) Birthday() {
+	p.Umur++
+}
+
+// Describe membuat Product memenuhi Describer
+func (prod Product) Describe() string {
+	status := "Tersedia"
+	if !prod.Tersedia {
+		status = "Habis"
+	}
+	return fmt.Sprintf("%s - Rp%.2f (%s)", prod.Nama, prod.Harga, status)
+}
+
+// String membuat Product memenuhi fmt.Stringer
+func (prod Product) String() string {
+	return "Product<" + prod.Nama + ">"
+}
+
+// Describe membuat Employee memenuhi Describer
+func (e Employee) Describe() string {
+	return fmt.Sprintf("Karyawan %s, tinggal di %s", e.Nama, e.Address.Kota)
+}
+
+// String membuat Employee memenuhi fmt.Stringer
+func (e Employee) String() string {
+	return "Employee<" + e.Nama + ">"
+}
+
+// =============================================================================
+// INTERFACE error
+// =============================================================================
+
+// StokError adalah custom error: cukup punya method Error() string
+type StokError struct {
+	Produk  string
+	Diminta int
+	Sisa    int
+}
+
+func (e *StokError) Error() string {
+	return fmt.Sprintf("stok %s tidak mencukupi: diminta %d, sisa %d", e.Produk, e.Diminta, e.Sisa)
+}
+
+// KurangiStok mengembalikan error (interface) alih-alih mencetak pesan
+func (prod *Product) KurangiStok(jumlah int) error {
+	if jumlah > prod.Stok {
+		return &StokError{Produk: prod.Nama, Diminta: jumlah, Sisa: prod.Stok}
+	}
+	prod.Stok -= jumlah
+	if prod.Stok == 0 {
+		prod.Tersedia = false
+	}
+	return nil
+}
+
+// NotFoundError dikembalikan jika data yang dicari tidak ada
+type NotFoundError struct {
+	Resource string
+	Kunci    string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s '%s' tidak ditemukan", e.Resource, e.Kunci)
+}
+
+// =============================================================================
+// COMPILE-TIME CHECK
+// =============================================================================
+// Assignment ke blank identifier memastikan tipe memenuhi interface.
+// Jika method hilang, program GAGAL DI-COMPILE (lebih cepat ketahuan).
+
+var (
+	_ Describer        = Person{}
+	_ Describer        = Product{}
+	_ Describer        = Employee{}
+	_ DescribeStringer = Person{}
+	_ DescribeStringer = Product{}
+	_ DescribeStringer = Employee{}
+	_ Pengenal         = Person{}     // Value receiver: Person cukup
+	_ BertambahUmur    = &Person{}    // Pointer receiver: harus *Person
+	_ error            = &StokError{} // Error() memakai pointer receiver
+	_ error            = &NotFoundError{}
+
+	// _ BertambahUmur = Person{}
+	// ❌ compile error: Person does not implement BertambahUmur
+	//    (method Birthday has pointer receiver)
+)
+
+// =============================================================================
+// FUNGSI YANG MENERIMA INTERFACE
+// =============================================================================
+
+// cetakSemua menerima slice Describer: isinya boleh tipe apa saja
+// selama memenuhi interface (polymorphism)
+func cetakSemua(items []Describer) {
+	for i, item := range items {
+		fmt.Printf("  %d. %s\n", i+1, item.Describe())
+	}
+}
+
+// jelaskan memakai TYPE SWITCH untuk menangani tiap tipe konkret
+// any adalah alias dari interface{} (interface tanpa method = semua tipe)
+func jelaskan(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case Person:
+		return "Person bernama " + v.Nama
+	case *Person:
+		return "Pointer ke Person bernama " + v.Nama
+	case Product:
+		return fmt.Sprintf("Product seharga Rp%.0f", v.Harga)
+	case error:
+		// Case interface: cocok untuk SEMUA tipe yang punya Error() string
+		return "Error: " + v.Error()
+	case Describer:
+		return "Describer lain: " + v.Describe()
+	case int, float64:
+		// Case dengan banyak tipe: v tetap bertipe any
+		return fmt.Sprintf("Angka %v", v)
+	default:
+		return fmt.Sprintf("Tipe tidak dikenal: %T", v)
+	}
+}
+
+// cariKaryawan mendemonstrasikan JEBAKAN NIL INTERFACE
+// Return type-nya error (interface), tapi yang dikembalikan *NotFoundError(nil)
+func cariKaryawan(daftar []Employee, nama string) error {
+	var err *NotFoundError // nil pointer bertipe *NotFoundError
+	if !adaKaryawan(daftar, nama) {
+		err = &NotFoundError{Resource: "karyawan", Kunci: nama}
+	}
+	return err // ❌ Interface berisi (tipe=*NotFoundError, nilai=nil) ≠ nil
+}
+
+// cariKaryawanBenar adalah versi yang benar: return nil secara eksplisit
+func cariKaryawanBenar(daftar []Employee, nama string) error {
+	if !adaKaryawan(daftar, nama) {
+		return &NotFoundError{Resource: "karyawan", Kunci: nama}
+	}
+	return nil // ✅ Interface nil sungguhan
+}
+
+func adaKaryawan(daftar []Employee, nama string) bool {
+	for _, e := range daftar {
+		if e.Nama == nama {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("INTERFACE")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	person := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+	laptop := Product{Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true}
+	employee := Employee{
+		Nama:    "Doni Pratama",
+		Umur:    28,
+		Address: Address{Jalan: "Jl. Gatot Subroto Kav. 12", Kota: "Jakarta Selatan", KodePos: "12930"},
+	}
+
+	// =============================================================================
+	// 1. IMPLEMENTASI IMPLISIT
+	// =============================================================================
+	// Person, Product, Employee tidak pernah "mendaftar" sebagai Describer
+	fmt.Println("--- 1. Implementasi Implisit ---")
+
+	var d Describer = person
+	fmt.Printf("Describer berisi %T: %s\n", d, d.Describe())
+
+	d = laptop // Variabel interface bisa diisi tipe lain yang memenuhi interface
+	fmt.Printf("Describer berisi %T: %s\n", d, d.Describe())
+
+	// =============================================================================
+	// 2. POLYMORPHISM
+	// =============================================================================
+	// Satu fungsi bisa bekerja dengan banyak tipe lewat interface
+	fmt.Println("\n--- 2. Polymorphism ---")
+
+	cetakSemua([]Describer{person, laptop, employee})
+
+	// =============================================================================
+	// 3. fmt.Stringer
+	// =============================================================================
+	// fmt memeriksa apakah nilai memenuhi fmt.Stringer lalu memanggil String()
+	fmt.Println("\n--- 3. fmt.Stringer ---")
+
+	fmt.Println("Println(person):", person)
+	fmt.Printf("Printf %%v: %v, %%s: %s\n", person, person)
+	fmt.Printf("Product dan Employee: %v, %v\n", laptop, employee)
+	fmt.Printf("Address tanpa String(): %v\n", employee.Address)
+
+	// =============================================================================
+	// 4. METHOD SET: VALUE vs POINTER RECEIVER
+	// =============================================================================
+	fmt.Println("\n--- 4. Method Set: Value vs Pointer Receiver ---")
+
+	// Perkenalan (value receiver): Person dan *Person sama-sama memenuhi Pengenal
+	var pengenal Pengenal = person
+	pengenal.Perkenalan()
+	pengenal = &person
+	pengenal.Perkenalan()
+
+	// Birthday (pointer receiver): hanya *Person yang memenuhi BertambahUmur
+	var ulangTahun BertambahUmur = &person
+	ulangTahun.Birthday()
+	fmt.Printf("Umur setelah Birthday lewat interface: %d\n", person.Umur)
+
+	// Menyimpan COPY (Person) ke interface: perubahan tidak terlihat di copy
+	var salinan Describer = person
+	person.Birthday()
+	fmt.Printf("Asli: %d tahun, copy di interface: %s\n", person.Umur, salinan.Describe())
+
+	// =============================================================================
+	// 5. TYPE ASSERTION
+	// =============================================================================
+	fmt.Println("\n--- 5. Type Assertion ---")
+
+	var item Describer = laptop
+
+	// Bentuk comma-ok: aman, tidak panic jika tipe salah
+	if prod, ok := item.(Product); ok {
+		fmt.Printf("item adalah Product, stok: %d\n", prod.Stok)
+	}
+	if _, ok := item.(Person); !ok {
+		fmt.Println("item BUKAN Person")
+	}
+
+	// Assertion ke interface lain: apakah item juga fmt.Stringer?
+	if s, ok := item.(fmt.Stringer); ok {
+		fmt.Printf("item juga Stringer: %s\n", s)
+	}
+	var alamat any = employee.Address
+	if _, ok := alamat.(fmt.Stringer); !ok {
+		fmt.Println("Address tidak punya String(), bukan fmt.Stringer")
+	}
+
+	// Bentuk tanpa ok akan PANIC jika salah tipe:
+	// p := item.(Person) // panic: interface conversion: main.Describer is main.Product, not main.Person
+
+	// =============================================================================
+	// 6. TYPE SWITCH
+	// =============================================================================
+	fmt.Println("\n--- 6. Type Switch ---")
+
+	values := []any{
+		person,
+		&person,
+		laptop,
+		employee,
+		laptop.KurangiStok(100),
+		42,
+		3.14,
+		"teks",
+		nil,
+	}
+	for _, v := range values {
+		fmt.Printf("  %s\n", jelaskan(v))
+	}
+
+	// =============================================================================
+	// 7. EMBEDDED INTERFACE
+	// =============================================================================
+	// DescribeStringer = Describer + fmt.Stringer
+	fmt.Println("\n--- 7. Embedded Interface ---")
+
+	var ds DescribeStringer = person
+	fmt.Printf("String(): %s | Describe(): %s\n", ds.String(), ds.Describe())
+
+	// Interface besar bisa dipakai di tempat interface kecil
+	var hanyaDescriber Describer = ds
+	fmt.Printf("Sebagai Describer: %s\n", hanyaDescriber.Describe())
+
+	// =============================================================================
+	// 8. INTERFACE error
+	// =============================================================================
+	// error hanyalah interface dengan satu method: Error() string
+	fmt.Println("\n--- 8. Interface error ---")
+
+	if err := laptop.KurangiStok(2); err == nil {
+		fmt.Printf("Berhasil, sisa stok %s: %d\n", laptop.Nama, laptop.Stok)
+	}
+
+	err := laptop.KurangiStok(50)
+	var stokErr *StokError
+	if errors.As(err, &stokErr) {
+		fmt.Printf("StokError: %v (sisa %d)\n", stokErr, stokErr.Sisa)
+	}
+
+	// =============================================================================
+	// 9. JEBAKAN NIL INTERFACE
+	// =============================================================================
+	// Nilai interface terdiri dari 2 bagian: (tipe, nilai)
+	// Interface == nil hanya jika KEDUANYA nil
+	fmt.Println("\n--- 9. Jebakan Nil Interface ---")
+
+	karyawan := []Employee{employee}
+
+	err = cariKaryawan(karyawan, employee.Nama)
+	fmt.Printf("cariKaryawan(ada)      : err == nil? %v, tipe: %T\n", err == nil, err)
+
+	err = cariKaryawanBenar(karyawan, employee.Nama)
+	fmt.Printf("cariKaryawanBenar(ada) : err == nil? %v, tipe: %T\n", err == nil, err)
+
+	err = cariKaryawanBenar(karyawan, "Siti Rahayu")
+	var notFound *NotFoundError
+	fmt.Printf("cariKaryawanBenar(tidak ada): %v (NotFoundError? %v)\n", err, errors.As(err, &notFound))
+
+	var nilPerson *Person
+	var nilDescriber Describer // nil sungguhan
+	fmt.Printf("nilDescriber == nil: %v\n", nilDescriber == nil)
+	nilDescriber = nilPerson // Berisi (tipe=*Person, nilai=nil)
+	fmt.Printf("Setelah diisi (*Person)(nil): nilDescriber == nil? %v\n", nilDescriber == nil)
+
+	// =============================================================================
+	// 10. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 10. Best Practices Interface ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Buat interface kecil (1-3 method), contoh: io.Reader, fmt.Stringer")
+	fmt.Println("   - Definisikan interface di sisi PEMAKAI, bukan di sisi implementasi")
+	fmt.Println("   - Terima interface, kembalikan struct konkret")
+	fmt.Println("   - Gunakan var _ Interface = Tipe{} untuk cek saat compile")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Membuat interface sebelum ada lebih dari satu implementasi")
+	fmt.Println("   - Mengembalikan pointer nil bertipe konkret sebagai error")
+	fmt.Println("   - Memakai any jika tipe sebenarnya sudah diketahui")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Interface: kontrak perilaku yang dipenuhi secara implisit")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureStdout menjalankan fn dan mengembalikan semua yang dicetak ke os.Stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	// Pipe dibaca di goroutine lain agar fn tidak terblokir saat buffer pipe penuh
+	out := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		out <- string(b)
+	}()
+
+	fn()
+	w.Close()
+	return <-out
+}
+
+// TestMainOutput sama dengan: go run main.go | diff - expected_output.txt
+// Perbarui golden file dengan: go run main.go > expected_output.txt
+func TestMainOutput(t *testing.T) {
+	want, err := os.ReadFile("expected_output.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := captureStdout(t, main)
+
+	gotLines := strings.Split(got, "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var g, w string
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			t.Fatalf("output berbeda di baris %d:\n got: %q\nwant: %q", i+1, g, w)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+/*
+================================================================================
+PELAJARAN 13: GOROUTINE DAN SYNC
+================================================================================
+
+APA ITU GOROUTINE?
+------------------
+Goroutine adalah fungsi yang berjalan BERSAMAAN (concurrent) dengan fungsi lain.
+Goroutine sangat ringan: ukuran stack awal hanya beberapa KB, sehingga program
+Go bisa menjalankan ribuan bahkan jutaan goroutine sekaligus.
+
+┌─────────────────────────────────────────┐
+│  go namaFungsi(argumen)                 │ ← jalankan fungsi di goroutine baru
+│  go func() { ... }()                    │ ← goroutine dengan fungsi anonim
+└─────────────────────────────────────────┘
+
+PENTING: main() juga goroutine. Jika main() selesai, SEMUA goroutine ikut
+berhenti, meskipun belum selesai bekerja.
+
+PAKET sync
+----------
+┌─────────────────┬──────────────────────────────────────────────┐
+│ Tipe            │ Kegunaan                                     │
+├─────────────────┼──────────────────────────────────────────────┤
+│ sync.WaitGroup  │ Menunggu sekumpulan goroutine selesai        │
+│ sync.Mutex      │ Mengunci data agar diakses satu per satu     │
+│ sync.RWMutex    │ Banyak pembaca ATAU satu penulis             │
+│ sync.Once       │ Menjalankan kode tepat satu kali             │
+│ sync/atomic     │ Operasi angka tanpa lock (lebih cepat)       │
+└─────────────────┴──────────────────────────────────────────────┘
+
+DATA RACE
+---------
+Data race terjadi jika dua goroutine mengakses variabel yang sama secara
+bersamaan dan minimal salah satunya MENULIS. Hasilnya tidak bisa diprediksi.
+
+Contoh: closure counter() dari pelajaran 08 menjalankan n++ yang sebenarnya
+terdiri dari 3 langkah: baca n, tambah 1, tulis n. Jika dua goroutine
+melakukannya bersamaan, salah satu penambahan bisa HILANG.
+
+RACE DETECTOR
+-------------
+Go punya race detector bawaan. Contoh yang SENGAJA mengandung data race
+(counter() dan stok tanpa lock) hanya dijalankan di mode race:
+    go run -race main.go race
+
+Mode default bebas data race, sehingga "go run -race main.go" tidak
+mencetak peringatan apa pun.
+
+Race detector akan mencetak laporan "WARNING: DATA RACE" beserta lokasi
+baca/tulis yang bertabrakan. (Butuh cgo/compiler C di beberapa sistem.)
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// =============================================================================
+// COUNTER DARI PELAJARAN 08
+// =============================================================================
+
+// counter adalah closure dari pelajaran 08 - TIDAK aman untuk goroutine
+func counter() func() int {
+	n := 0
+	return func() int {
+		n++ // ❌ Data race jika dipanggil dari banyak goroutine
+		return n
+	}
+}
+
+// counterMutex sama seperti counter, tapi n dilindungi sync.Mutex
+func counterMutex() func() int {
+	var mu sync.Mutex
+	n := 0
+	return func() int {
+		mu.Lock()         // Hanya satu goroutine yang bisa lewat
+		defer mu.Unlock() // defer memastikan Unlock selalu dipanggil
+		n++
+		return n
+	}
+}
+
+// counterAtomic memakai sync/atomic: tanpa lock, cocok untuk angka sederhana
+func counterAtomic() func() int {
+	var n atomic.Int64
+	return func() int {
+		return int(n.Add(1)) // Baca-tambah-tulis dalam SATU operasi atomik
+	}
+}
+
+// =============================================================================
+// PRODUCT DARI PELAJARAN 09
+// =============================================================================
+
+// Product adalah barang dagangan dengan stok yang bisa dibeli bersamaan
+// mu melindungi Stok dan Tersedia dari akses bersamaan
+type Product struct {
+	Nama     string
+	Harga    float64
+	Stok     int
+	Tersedia bool
+
+	mu sync.Mutex // Unexported: pemakai tidak perlu tahu ada lock
+}
+
+// KurangiStok aman dipanggil dari banyak goroutine
+// Mengembalikan false jika stok tidak mencukupi
+func (prod *Product) KurangiStok(jumlah int) bool {
+	prod.mu.Lock()
+	defer prod.mu.Unlock()
+
+	// Cek dan ubah stok harus dalam satu lock yang sama.
+	// Jika cek dan ubah dipisah, dua pembeli bisa lolos cek bersamaan.
+	if jumlah > prod.Stok {
+		return false
+	}
+	prod.Stok -= jumlah
+	if prod.Stok == 0 {
+		prod.Tersedia = false
+	}
+	return true
+}
+
+// kurangiStokTanpaLock adalah versi pelajaran 09 yang tidak aman
+func kurangiStokTanpaLock(prod *Product, jumlah int) bool {
+	if jumlah > prod.Stok {
+		return false
+	}
+	time.Sleep(time.Microsecond) // Perbesar jarak antara cek dan ubah
+	prod.Stok -= jumlah
+	return true
+}
+
+// =============================================================================
+// sync.Once
+// =============================================================================
+
+var (
+	configOnce sync.Once
+	config     map[string]string
+)
+
+// loadConfig hanya benar-benar memuat config SEKALI, berapa pun pemanggilnya
+func loadConfig() map[string]string {
+	configOnce.Do(func() {
+		fmt.Println("  [loadConfig] Memuat konfigurasi... (hanya sekali)")
+		config = map[string]string{"toko": "Toko Budi", "kota": "Jakarta"}
+	})
+	return config
+}
+
+// =============================================================================
+// DEMO RACE (JALANKAN DENGAN -race)
+// =============================================================================
+
+// beliBersamaan menjalankan 20 pembeli sekaligus, masing-masing memanggil
+// beli satu kali, lalu mengembalikan jumlah pembelian yang berhasil
+func beliBersamaan(beli func() bool) int {
+	var berhasil atomic.Int32
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if beli() {
+				berhasil.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	return int(berhasil.Load())
+}
+
+// raceDemo sengaja mengandung data race untuk dilaporkan race detector
+func raceDemo() {
+	const jumlahGoroutine = 1000
+	fmt.Printf("Menjalankan counter() dari %d goroutine tanpa sinkronisasi...\n", jumlahGoroutine)
+	hitung := counter()
+
+	var wg sync.WaitGroup
+	for range jumlahGoroutine {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hitung()
+		}()
+	}
+	wg.Wait()
+	// Panggilan terakhir menambah 1, jadi dikurangi 1
+	fmt.Printf("Hasil: %d (seharusnya %d, bisa kurang!)\n", hitung()-1, jumlahGoroutine)
+
+	fmt.Println("\n20 pembeli membeli 1 unit dari stok 10 tanpa lock...")
+	laptop := &Product{Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true}
+	n := beliBersamaan(func() bool { return kurangiStokTanpaLock(laptop, 1) })
+	fmt.Printf("Tanpa lock: %d pembelian berhasil, sisa stok %d (bisa oversold!)\n", n, laptop.Stok)
+}
+
+func main() {
+	// Mode khusus untuk race detector: go run -race main.go race
+	if len(os.Args) > 1 && os.Args[1] == "race" {
+		raceDemo()
+		return
+	}
+
+	fmt.Println("================================================================================")
+	fmt.Println("GOROUTINE DAN SYNC")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. STATEMENT go
+	// =============================================================================
+	// Tanpa menunggu, main bisa selesai sebelum goroutine sempat berjalan
+	fmt.Println("--- 1. Statement go ---")
+
+	go fmt.Println("  Goroutine: mungkin tidak pernah tercetak!")
+	fmt.Println("  main tidak menunggu goroutine")
+	time.Sleep(10 * time.Millisecond) // ❌ Cara buruk menunggu, lihat WaitGroup
+
+	// =============================================================================
+	// 2. sync.WaitGroup
+	// =============================================================================
+	// Add(n) sebelum goroutine dimulai, Done() saat selesai, Wait() menunggu semua
+	fmt.Println("\n--- 2. sync.WaitGroup ---")
+
+	var wg sync.WaitGroup
+	hasil := make([]int, 5) // Setiap goroutine menulis ke index berbeda: aman
+
+	for i := range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hasil[i] = (i + 1) * (i + 1) // Sejak Go 1.22, i adalah variabel baru per iterasi
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("Kuadrat 1-5 (dihitung paralel): %v\n", hasil)
+
+	// =============================================================================
+	// 3. DATA RACE PADA counter()
+	// =============================================================================
+	// counter() aman selama hanya dipanggil dari SATU goroutine.
+	// Versi yang dipakai bersama banyak goroutine ada di mode race.
+	fmt.Println("\n--- 3. Data Race pada counter() ---")
+
+	hitungSatuGoroutine := counter()
+	fmt.Printf("Satu goroutine: %d, %d, %d (aman)\n", hitungSatuGoroutine(), hitungSatuGoroutine(), hitungSatuGoroutine())
+	fmt.Println("Banyak goroutine: n++ = baca, tambah, tulis → penambahan bisa HILANG")
+	fmt.Println("Lihat sendiri dengan: go run -race main.go race")
+
+	const jumlahGoroutine = 1000
+
+	// =============================================================================
+	// 4. PERBAIKAN DENGAN sync.Mutex
+	// =============================================================================
+	fmt.Println("\n--- 4. Perbaikan dengan sync.Mutex ---")
+
+	hitungMutex := counterMutex()
+	wg = sync.WaitGroup{}
+	for range jumlahGoroutine {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hitungMutex()
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("Dengan Mutex: %d (selalu tepat)\n", hitungMutex()-1)
+
+	// =============================================================================
+	// 5. PERBAIKAN DENGAN sync/atomic
+	// =============================================================================
+	fmt.Println("\n--- 5. Perbaikan dengan sync/atomic ---")
+
+	hitungAtomic := counterAtomic()
+	wg = sync.WaitGroup{}
+	for range jumlahGoroutine {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hitungAtomic()
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("Dengan atomic: %d (selalu tepat, tanpa lock)\n", hitungAtomic()-1)
+
+	// =============================================================================
+	// 6. STOK PRODUK DIBELI BERSAMAAN
+	// =============================================================================
+	// 20 pembeli membeli 1 unit dari stok 10: hanya 10 yang boleh berhasil.
+	// Versi tanpa lock (kurangiStokTanpaLock) hanya dijalankan di mode race.
+	fmt.Println("\n--- 6. Stok Produk Dibeli Bersamaan ---")
+
+	laptop := &Product{Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true}
+	n := beliBersamaan(func() bool { return laptop.KurangiStok(1) })
+	fmt.Printf("Dengan Mutex: %d pembelian berhasil, sisa stok %d, tersedia: %v\n", n, laptop.Stok, laptop.Tersedia)
+
+	// =============================================================================
+	// 7. sync.Once
+	// =============================================================================
+	// Cocok untuk inisialisasi malas (lazy initialization) yang aman dari race
+	fmt.Println("\n--- 7. sync.Once ---")
+
+	wg = sync.WaitGroup{}
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			loadConfig()
+		}()
+	}
+	wg.Wait()
+	fmt.Printf("Config: toko=%s, kota=%s\n", loadConfig()["toko"], loadConfig()["kota"])
+
+	// =============================================================================
+	// 8. RACE DETECTOR
+	// =============================================================================
+	fmt.Println("\n--- 8. Race Detector ---")
+	fmt.Println("Jalankan demo race dengan race detector:")
+	fmt.Println("  go run -race main.go race")
+	fmt.Println("Output akan berisi \"WARNING: DATA RACE\" dan menunjuk baris n++ di counter()")
+	fmt.Println("serta baris prod.Stok di kurangiStokTanpaLock()")
+	fmt.Println("Mode default (go run -race main.go) tidak punya data race")
+	fmt.Println("Tips: jalankan juga test dengan go test -race ./...")
+
+	// =============================================================================
+	// 9. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 9. Best Practices Goroutine ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Pastikan setiap goroutine punya cara untuk selesai")
+	fmt.Println("   - Gunakan WaitGroup untuk menunggu, bukan time.Sleep")
+	fmt.Println("   - Lindungi data bersama dengan Mutex atau atomic")
+	fmt.Println("   - Jalankan program dan test dengan -race")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Menyalin struct yang berisi sync.Mutex (go vet akan memperingatkan)")
+	fmt.Println("   - Memisahkan cek dan ubah data ke lock yang berbeda")
+	fmt.Println("   - Memanggil wg.Add di dalam goroutine yang ditunggu")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Goroutine murah, tapi data bersama harus disinkronkan")
+	fmt.Println("================================================================================")
+}
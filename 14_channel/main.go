@@ -0,0 +1,410 @@
+/*
+================================================================================
+PELAJARAN 14: CHANNEL, SELECT, DAN PIPELINE
+================================================================================
+
+APA ITU CHANNEL?
+----------------
+Channel adalah "pipa" untuk mengirim data antar goroutine.
+Filosofi Go: "Jangan berkomunikasi dengan berbagi memory,
+              berbagilah memory dengan berkomunikasi."
+
+┌────────────────────────────────────────────────────────┐
+│  ch := make(chan int)        // unbuffered             │
+│  ch := make(chan int, 3)     // buffered, kapasitas 3  │
+│  ch <- 10                    // kirim                  │
+│  nilai := <-ch               // terima                 │
+│  nilai, ok := <-ch           // ok=false jika ditutup  │
+│  close(ch)                   // tutup (oleh PENGIRIM)  │
+└────────────────────────────────────────────────────────┘
+
+UNBUFFERED vs BUFFERED
+----------------------
+┌─────────────┬──────────────────────────────┬──────────────────────────────┐
+│             │ UNBUFFERED make(chan T)      │ BUFFERED make(chan T, n)     │
+├─────────────┼──────────────────────────────┼──────────────────────────────┤
+│ Kirim       │ Menunggu sampai ada penerima │ Menunggu hanya jika penuh    │
+│ Terima      │ Menunggu sampai ada pengirim │ Menunggu hanya jika kosong   │
+│ Sifat       │ Sinkronisasi (serah terima)  │ Antrian dengan kapasitas     │
+└─────────────┴──────────────────────────────┴──────────────────────────────┘
+
+ARAH CHANNEL
+------------
+- chan<- int : hanya bisa KIRIM
+- <-chan int : hanya bisa TERIMA
+Parameter fungsi sebaiknya memakai arah agar kesalahan ketahuan saat compile.
+
+PIPELINE
+--------
+Contoh "PENJUMLAHAN" (pelajaran 05) dan sum() (pelajaran 08) dibangun ulang
+sebagai rangkaian tahap yang berjalan bersamaan:
+
+    generator ──► square ──► sum
+    (10,20,..)    (n*n)      (total)
+
+SELECT
+------
+select menunggu beberapa operasi channel sekaligus, lalu menjalankan yang
+pertama siap. Dengan time.After, select bisa dipakai untuk TIMEOUT.
+
+DEADLOCK
+--------
+Jika semua goroutine menunggu channel dan tidak ada yang bisa melanjutkan,
+runtime menghentikan program: "fatal error: all goroutines are asleep - deadlock!"
+Ini BUKAN panic, sehingga TIDAK bisa di-recover. Pelajaran ini menjalankan
+demo deadlock di proses terpisah agar program utama tetap berjalan.
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// TAHAP-TAHAP PIPELINE
+// =============================================================================
+
+// generator mengirim setiap angka ke channel lalu menutupnya
+// Return type <-chan int: pemanggil hanya bisa menerima
+func generator(angka ...int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out) // Tanpa close, range di penerima tidak akan pernah berhenti
+		for _, n := range angka {
+			out <- n
+		}
+	}()
+	return out
+}
+
+// square menerima angka dari in, mengirim kuadratnya ke channel baru
+func square(in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in { // range berhenti saat in ditutup
+			out <- n * n
+		}
+	}()
+	return out
+}
+
+// sum menjumlahkan semua angka dari channel (versi channel dari sum pelajaran 08)
+func sum(in <-chan int) int {
+	total := 0
+	for n := range in {
+		total += n
+	}
+	return total
+}
+
+// =============================================================================
+// FAN-OUT DAN FAN-IN
+// =============================================================================
+
+// hasilWorker mencatat hasil kuadrat beserta worker yang menghitungnya
+type hasilWorker struct {
+	Worker string
+	Nilai  int
+}
+
+// slowSquare sama seperti square, tapi pura-pura lambat dan mencatat nama worker
+func slowSquare(nama string, in <-chan int) <-chan hasilWorker {
+	out := make(chan hasilWorker)
+	go func() {
+		defer close(out)
+		for n := range in {
+			time.Sleep(10 * time.Millisecond) // Simulasi pekerjaan berat
+			out <- hasilWorker{Worker: nama, Nilai: n * n}
+		}
+	}()
+	return out
+}
+
+// merge (fan-in) menggabungkan banyak channel menjadi satu
+// Channel hasil ditutup setelah SEMUA channel input ditutup
+func merge[T any](inputs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range inputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range in {
+				out <- v
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// =============================================================================
+// SELECT DENGAN TIMEOUT
+// =============================================================================
+
+// ambilDenganTimeout menunggu hasil dari ch paling lama selama timeout
+func ambilDenganTimeout(ch <-chan string, timeout time.Duration) (string, error) {
+	select {
+	case v := <-ch:
+		return v, nil
+	case <-time.After(timeout):
+		return "", fmt.Errorf("timeout setelah %v", timeout)
+	}
+}
+
+// lambat mengirim pesan ke channel setelah delay tertentu
+func lambat(pesan string, delay time.Duration) <-chan string {
+	ch := make(chan string, 1) // Buffered: goroutine tidak bocor jika tidak ada penerima
+	go func() {
+		time.Sleep(delay)
+		ch <- pesan
+	}()
+	return ch
+}
+
+// =============================================================================
+// DEMO DEADLOCK
+// =============================================================================
+
+// deadlockDemo sengaja membuat deadlock: kirim ke unbuffered channel tanpa penerima
+func deadlockDemo() {
+	ch := make(chan int)
+	ch <- 1 // Menunggu penerima selamanya
+	fmt.Println("baris ini tidak pernah tercetak")
+}
+
+// raceAktif mengembalikan true jika program di-build dengan -race. Di build
+// ini runtime tidak mendeteksi deadlock "all goroutines are asleep", sehingga
+// proses anak di jalankanDeadlockDemo akan menunggu selamanya.
+func raceAktif() bool {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return false
+	}
+	for _, s := range info.Settings {
+		if s.Key == "-race" {
+			return s.Value == "true"
+		}
+	}
+	return false
+}
+
+// jalankanDeadlockDemo menjalankan program ini sendiri dengan argumen "deadlock"
+// sebagai proses terpisah, lalu menampilkan baris pertama pesan error-nya
+func jalankanDeadlockDemo() {
+	if raceAktif() {
+		fmt.Println("Dilewati: build -race tidak mendeteksi deadlock, proses anak akan menggantung")
+		return
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(exe, "deadlock")
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+
+	firstLine, _, _ := strings.Cut(stderr.String(), "\n")
+	fmt.Printf("Proses anak berhenti dengan error: %v\n", err != nil)
+	fmt.Printf("Pesan runtime: %s\n", firstLine)
+}
+
+func main() {
+	// Mode khusus untuk demo deadlock, dipanggil oleh jalankanDeadlockDemo
+	if len(os.Args) > 1 && os.Args[1] == "deadlock" {
+		deadlockDemo()
+		return
+	}
+
+	fmt.Println("================================================================================")
+	fmt.Println("CHANNEL, SELECT, DAN PIPELINE")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. UNBUFFERED CHANNEL
+	// =============================================================================
+	// Pengirim dan penerima harus "bertemu": kirim menunggu sampai diterima
+	fmt.Println("--- 1. Unbuffered Channel ---")
+
+	pesan := make(chan string)
+	go func() {
+		pesan <- "Halo dari goroutine!" // Menunggu main siap menerima
+	}()
+	fmt.Printf("Diterima: %s\n", <-pesan)
+
+	// Channel sebagai sinyal selesai (pengganti WaitGroup untuk satu goroutine)
+	selesai := make(chan struct{})
+	go func() {
+		fmt.Println("Goroutine bekerja...")
+		close(selesai) // close juga membangunkan semua penerima
+	}()
+	<-selesai
+	fmt.Println("Goroutine selesai")
+
+	// =============================================================================
+	// 2. BUFFERED CHANNEL
+	// =============================================================================
+	// Kirim tidak menunggu selama buffer belum penuh
+	fmt.Println("\n--- 2. Buffered Channel ---")
+
+	antrian := make(chan string, 3)
+	antrian <- "Budi"
+	antrian <- "Ani"
+	antrian <- "Caca"
+	// antrian <- "Doni" // Buffer penuh: di main ini akan DEADLOCK
+	fmt.Printf("len=%d, cap=%d\n", len(antrian), cap(antrian))
+	fmt.Printf("Pertama keluar: %s (FIFO)\n", <-antrian)
+	fmt.Printf("len setelah terima: %d\n", len(antrian))
+
+	// =============================================================================
+	// 3. CLOSE DAN RANGE OVER CHANNEL
+	// =============================================================================
+	fmt.Println("\n--- 3. close dan range ---")
+
+	close(antrian) // Data yang tersisa di buffer masih bisa diterima
+	for nama := range antrian {
+		fmt.Printf("Sisa antrian: %s\n", nama)
+	}
+
+	// Menerima dari channel tertutup: zero value dan ok=false
+	nilai, ok := <-antrian
+	fmt.Printf("Terima dari channel tertutup: %q, ok=%v\n", nilai, ok)
+
+	// =============================================================================
+	// 4. PIPELINE: PENJUMLAHAN (PELAJARAN 05)
+	// =============================================================================
+	// Versi serial: for _, nilai := range angka { total += nilai }
+	// Versi pipeline: generator ──► sum
+	fmt.Println("\n--- 4. Pipeline: Penjumlahan ---")
+
+	angka := []int{10, 20, 30, 40, 50}
+	fmt.Printf("Angka: %v\n", angka)
+	fmt.Printf("Total (generator ──► sum): %d\n", sum(generator(angka...)))
+
+	// =============================================================================
+	// 5. PIPELINE: JUMLAH KUADRAT
+	// =============================================================================
+	// Tahap bisa disusun seperti fungsi biasa: sum(square(generator(...)))
+	fmt.Println("\n--- 5. Pipeline: generator ──► square ──► sum ---")
+
+	fmt.Printf("1² + 2² + 3² + 4² + 5² = %d\n", sum(square(generator(1, 2, 3, 4, 5))))
+	fmt.Printf("Dua kali square (pangkat 4): %d\n", sum(square(square(generator(1, 2, 3)))))
+
+	// =============================================================================
+	// 6. FAN-OUT DAN FAN-IN
+	// =============================================================================
+	// Fan-out: beberapa worker membaca dari channel yang SAMA
+	// Fan-in : hasil semua worker digabung ke satu channel (merge)
+	fmt.Println("\n--- 6. Fan-out dan Fan-in ---")
+
+	sumber := generator(1, 2, 3, 4, 5, 6)
+	worker1 := slowSquare("w1", sumber)
+	worker2 := slowSquare("w2", sumber)
+	worker3 := slowSquare("w3", sumber)
+
+	// Ketiga worker tidur 10ms per angka secara bersamaan, jadi waktu totalnya
+	// sekitar 2 x 10ms, bukan 6 x 10ms. Durasinya tidak dicetak karena
+	// bergantung pada mesin, sehingga output tetap bisa dibandingkan dengan
+	// expected_output.txt.
+	total, jumlahHasil := 0, 0
+	for hasil := range merge(worker1, worker2, worker3) {
+		// Urutan hasil dan worker yang mengerjakan tidak pasti,
+		// tapi totalnya selalu sama
+		total += hasil.Nilai
+		jumlahHasil++
+	}
+	fmt.Printf("%d hasil dari 3 worker, total kuadrat: %d\n", jumlahHasil, total)
+
+	// =============================================================================
+	// 7. SELECT
+	// =============================================================================
+	// select memilih case yang pertama siap.
+	// Jika beberapa case siap BERSAMAAN, salah satunya dipilih secara acak,
+	// karena itu contoh ini memakai server yang tidak pernah menjawab agar
+	// hasilnya selalu sama.
+	fmt.Println("\n--- 7. select ---")
+
+	cepat := lambat("server cepat", 10*time.Millisecond)
+	mati := make(chan string) // Server mati: tidak pernah mengirim apa pun
+	select {
+	case v := <-cepat:
+		fmt.Printf("Pemenang: %s\n", v)
+	case v := <-mati:
+		fmt.Printf("Pemenang: %s\n", v)
+	}
+
+	// default: select tidak menunggu jika tidak ada case yang siap
+	kosong := make(chan int)
+	select {
+	case v := <-kosong:
+		fmt.Printf("Diterima: %d\n", v)
+	default:
+		fmt.Println("Tidak ada data, lanjut tanpa menunggu (default)")
+	}
+
+	// =============================================================================
+	// 8. TIMEOUT DENGAN time.After
+	// =============================================================================
+	fmt.Println("\n--- 8. Timeout dengan time.After ---")
+
+	// Data yang sudah ada di buffer selalu menang dari timer yang belum habis
+	siap := make(chan string, 1)
+	siap <- "data siap"
+	if v, err := ambilDenganTimeout(siap, 100*time.Millisecond); err == nil {
+		fmt.Printf("Sukses: %s\n", v)
+	}
+	// Channel yang tidak pernah dikirimi selalu berakhir dengan timeout
+	if _, err := ambilDenganTimeout(make(chan string), 50*time.Millisecond); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	// =============================================================================
+	// 9. DEADLOCK
+	// =============================================================================
+	// Deadlock adalah fatal error: recover() tidak bisa menangkapnya.
+	// Demo dijalankan sebagai proses terpisah.
+	fmt.Println("\n--- 9. Deadlock ---")
+
+	jalankanDeadlockDemo()
+
+	// =============================================================================
+	// 10. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 10. Best Practices Channel ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Hanya PENGIRIM yang menutup channel")
+	fmt.Println("   - Gunakan arah channel (<-chan, chan<-) di parameter fungsi")
+	fmt.Println("   - Pastikan setiap goroutine pengirim punya cara untuk berhenti")
+	fmt.Println("   - Gunakan select + time.After untuk operasi yang bisa lama")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Mengirim ke channel yang sudah ditutup (panic)")
+	fmt.Println("   - Menutup channel dua kali (panic)")
+	fmt.Println("   - Mengirim ke unbuffered channel tanpa penerima (deadlock)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Channel: komunikasi aman antar goroutine")
+	fmt.Println("================================================================================")
+}
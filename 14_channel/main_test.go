@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// TestMain menangani dua mode proses anak. Di dalam go test, executable
+// saat ini adalah binary test ini sendiri:
+//   - "deadlock": dipakai jalankanDeadlockDemo. deadlockDemo dipanggil
+//     sebelum m.Run agar belum ada goroutine lain dan runtime bisa
+//     mendeteksi deadlock.
+//   - "main": menjalankan main() apa adanya untuk TestMainOutput.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "deadlock":
+			deadlockDemo()
+		case "main":
+			main()
+			os.Exit(0)
+		}
+	}
+	os.Exit(m.Run())
+}
+
+// TestMainOutput sama dengan: go run main.go | diff - expected_output.txt
+// Perbarui golden file dengan: go run main.go > expected_output.txt
+func TestMainOutput(t *testing.T) {
+	if raceAktif() {
+		t.Skip("build -race melewati demo deadlock, output berbeda dari golden file")
+	}
+	want, err := os.ReadFile("expected_output.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := exec.Command(os.Args[0], "main").Output()
+	if err != nil {
+		t.Fatalf("menjalankan main: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output berbeda dari expected_output.txt\n got:\n%s\nwant:\n%s", got, want)
+	}
+}
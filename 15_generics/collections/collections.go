@@ -0,0 +1,138 @@
+// Package collections berisi fungsi generic untuk slice dan map.
+// Fungsi-fungsi di sini adalah versi generic dari helper int-only di
+// pelajaran 06 (hapus elemen slice), 08 (sum, tambah) dan 10 (Swap).
+package collections
+
+import (
+	"cmp"
+	"slices"
+)
+
+// =============================================================================
+// CONSTRAINT
+// =============================================================================
+
+// Integer adalah semua tipe bilangan bulat.
+// Tanda ~ berarti "tipe apa pun yang underlying type-nya int", sehingga tipe
+// buatan sendiri seperti `type Rupiah int` juga termasuk.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float adalah semua tipe bilangan desimal
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number adalah gabungan Integer dan Float: semua tipe yang bisa dijumlahkan
+type Number interface {
+	Integer | Float
+}
+
+// =============================================================================
+// VERSI GENERIC DARI HELPER PELAJARAN 08 DAN 10
+// =============================================================================
+
+// Sum menjumlahkan semua angka (versi generic dari sum(angka ...int))
+func Sum[T Number](angka ...T) T {
+	var total T // Zero value T: 0 untuk semua tipe Number
+	for _, n := range angka {
+		total += n
+	}
+	return total
+}
+
+// Tambah menjumlahkan dua angka (versi generic dari tambah(a, b int))
+func Tambah[T Number](a, b T) T {
+	return a + b
+}
+
+// Swap menukar nilai yang ditunjuk dua pointer (versi generic dari Swap(a, b *int))
+// Constraint any cukup karena Swap tidak memakai operator apa pun
+func Swap[T any](a, b *T) {
+	*a, *b = *b, *a
+}
+
+// =============================================================================
+// SLICE
+// =============================================================================
+// Semua fungsi slice memakai bentuk [S ~[]E, E any], sama seperti package
+// slices di standard library, sehingga tipe slice buatan sendiri bisa
+// langsung dipakai tanpa konversi.
+
+// DeleteAt menghapus elemen di index i (versi generic dari trik pelajaran 06)
+// S ~[]E berarti S boleh tipe slice buatan sendiri, misal `type Names []string`,
+// dan hasilnya tetap bertipe S (bukan []E).
+// Seperti append(s[:i], s[i+1:]...), array di balik s ikut berubah.
+// Panic jika i di luar jangkauan, sama seperti slices.Delete.
+func DeleteAt[S ~[]E, E any](s S, i int) S {
+	return append(s[:i], s[i+1:]...)
+}
+
+// Map mengubah setiap elemen slice dengan fungsi f.
+// Tipe elemen E dan hasil U boleh berbeda, karena itu hasilnya []U, bukan S.
+// Slice nil atau kosong menghasilkan nil.
+func Map[S ~[]E, E, U any](s S, f func(E) U) []U {
+	if len(s) == 0 {
+		return nil
+	}
+	result := make([]U, 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v))
+	}
+	return result
+}
+
+// Filter mengambil elemen yang membuat keep bernilai true.
+// Hasilnya nil jika tidak ada elemen yang lolos (termasuk untuk slice nil).
+func Filter[S ~[]E, E any](s S, keep func(E) bool) S {
+	var result S
+	for _, v := range s {
+		if keep(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Reduce menggabungkan semua elemen menjadi satu nilai, dimulai dari initial.
+// Untuk slice nil atau kosong, initial dikembalikan apa adanya.
+func Reduce[S ~[]E, E, A any](s S, initial A, f func(A, E) A) A {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// =============================================================================
+// MAP
+// =============================================================================
+
+// Keys mengembalikan semua key map (urutannya acak, seperti range map)
+// K harus comparable karena semua key map harus bisa dibandingkan dengan ==
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values mengembalikan semua value map (urutannya acak)
+func Values[M ~map[K]V, K comparable, V any](m M) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// SortedKeys mengembalikan key map yang sudah terurut
+// cmp.Ordered adalah constraint bawaan untuk tipe yang mendukung < dan >
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
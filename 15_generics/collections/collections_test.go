@@ -0,0 +1,93 @@
+package collections
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// names adalah tipe slice buatan, untuk memastikan S ~[]E bekerja
+type names []string
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []string
+	}{
+		{"nil", nil, nil},
+		{"kosong", []int{}, nil},
+		{"satu elemen", []int{7}, []string{"7"}},
+		{"banyak elemen", []int{1, 2, 3}, []string{"1", "2", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map(tt.in, strconv.Itoa)
+			if !slices.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("Map(%v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	genap := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"nil", nil, nil},
+		{"kosong", []int{}, nil},
+		{"tidak ada yang lolos", []int{1, 3, 5}, nil},
+		{"sebagian lolos", []int{1, 2, 3, 4}, []int{2, 4}},
+		{"semua lolos", []int{2, 4}, []int{2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Filter(tt.in, genap)
+			if !slices.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("Filter(%v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	jumlah := func(acc, n int) int { return acc + n }
+	tests := []struct {
+		name    string
+		in      []int
+		initial int
+		want    int
+	}{
+		{"nil mengembalikan initial", nil, 10, 10},
+		{"kosong mengembalikan initial", []int{}, 0, 0},
+		{"satu elemen", []int{5}, 0, 5},
+		{"banyak elemen", []int{1, 2, 3, 4}, 10, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.in, tt.initial, jumlah); got != tt.want {
+				t.Errorf("Reduce(%v, %d) = %d, want %d", tt.in, tt.initial, got, tt.want)
+			}
+		})
+	}
+}
+
+// Tipe slice buatan diterima oleh ketiga fungsi, dan Filter tetap
+// mengembalikan tipe yang sama (names, bukan []string)
+func TestNamedSliceType(t *testing.T) {
+	in := names{"ani", "budi", "citra"}
+
+	var filtered names = Filter(in, func(s string) bool { return len(s) > 3 })
+	if !slices.Equal(filtered, names{"budi", "citra"}) {
+		t.Errorf("Filter = %v", filtered)
+	}
+	if got := Map(in, func(s string) int { return len(s) }); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Map = %v", got)
+	}
+	if got := Reduce(in, "", func(acc, s string) string { return acc + s[:1] }); got != "abc" {
+		t.Errorf("Reduce = %q, want %q", got, "abc")
+	}
+}
@@ -0,0 +1,262 @@
+/*
+================================================================================
+PELAJARAN 15: GENERICS
+================================================================================
+
+MASALAH: FUNGSI YANG HANYA UNTUK SATU TIPE
+------------------------------------------
+Di pelajaran sebelumnya banyak fungsi yang hanya bekerja untuk int:
+- sum(angka ...int) int          (pelajaran 08)
+- tambah(a, b int) int           (pelajaran 08)
+- Swap(a, b *int)                (pelajaran 10)
+- append(names[:i], names[i+1:]...) untuk []string (pelajaran 06)
+
+Untuk float64 kita harus menulis ulang fungsi yang SAMA PERSIS. Generics
+(Go 1.18+) memungkinkan satu fungsi bekerja untuk banyak tipe.
+
+ANATOMI FUNGSI GENERIC
+----------------------
+┌──────────────────────────────────────────────┐
+│  func Nama[T Constraint](param T) T {        │
+│      // kode yang sama untuk semua tipe T    │
+│  }                                           │
+└──────────────────────────────────────────────┘
+- [T Constraint] : TYPE PARAMETER beserta batasannya
+- Constraint     : interface yang membatasi tipe apa saja yang boleh
+
+CONSTRAINT
+----------
+┌─────────────────┬────────────────────────────────────────────────┐
+│ Constraint      │ Arti                                           │
+├─────────────────┼────────────────────────────────────────────────┤
+│ any             │ Semua tipe (tidak bisa pakai operator)         │
+│ comparable      │ Tipe yang bisa dibandingkan dengan == dan !=   │
+│ cmp.Ordered     │ Tipe yang bisa dibandingkan dengan < dan >     │
+│ int | float64   │ Union: hanya tipe yang disebutkan              │
+│ ~int            │ int DAN tipe buatan dengan underlying int      │
+└─────────────────┴────────────────────────────────────────────────┘
+
+PACKAGE collections
+-------------------
+Pelajaran ini memakai package sendiri di folder collections/ yang berisi
+Sum, Tambah, Swap, DeleteAt, Map, Filter, Reduce, Keys, Values, SortedKeys.
+
+STANDARD LIBRARY: slices DAN maps
+---------------------------------
+Sejak Go 1.21, banyak helper generic sudah tersedia di standard library:
+slices.Delete, slices.Contains, slices.Index, slices.Max, slices.Sort,
+maps.Keys, maps.Values (mengembalikan iterator, Go 1.23+).
+Gunakan standard library jika sudah ada, buat sendiri jika belum.
+*/
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"learn-go/15_generics/collections"
+)
+
+// Rupiah adalah tipe buatan dengan underlying type int64
+// Karena constraint Number memakai ~int64, Rupiah bisa dipakai di Sum
+type Rupiah int64
+
+// Names adalah tipe slice buatan, dipakai untuk menunjukkan S ~[]E
+type Names []string
+
+// Person dari pelajaran 09
+type Person struct {
+	Nama string
+	Umur int
+}
+
+// Pair adalah STRUCT GENERIC: type parameter juga bisa dipakai di struct
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// String membuat Pair mudah dicetak
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("%v=%v", p.Key, p.Value)
+}
+
+// Max mengembalikan nilai terbesar, contoh constraint yang didefinisikan inline
+func Max[T interface{ ~int | ~float64 | ~string }](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("GENERICS")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. SATU FUNGSI UNTUK BANYAK TIPE
+	// =============================================================================
+	// Dulu: sum(angka ...int) hanya untuk int
+	// Sekarang: collections.Sum bekerja untuk semua tipe Number
+	fmt.Println("--- 1. Sum dan Tambah Generic ---")
+
+	fmt.Printf("Sum int      : %d\n", collections.Sum(1, 2, 3, 4, 5))
+	fmt.Printf("Sum float64  : %.2f\n", collections.Sum(1.5, 2.25, 3.75))
+	fmt.Printf("Sum Rupiah   : %d\n", collections.Sum(Rupiah(15000), Rupiah(2500)))
+	fmt.Printf("Tambah int   : %d\n", collections.Tambah(5, 3))
+	fmt.Printf("Tambah float : %.1f\n", collections.Tambah(2.5, 0.5))
+
+	// Pass slice ke variadic generic, sama seperti sum(angka...)
+	nilai := []int{90, 85, 88, 92}
+	fmt.Printf("Sum(slice...): %d\n", collections.Sum(nilai...))
+
+	// =============================================================================
+	// 2. TYPE INFERENCE
+	// =============================================================================
+	// Biasanya Go bisa menebak T dari argumen, tapi T juga bisa ditulis eksplisit
+	fmt.Println("\n--- 2. Type Inference ---")
+
+	fmt.Printf("Sum[float64](1, 2): %.1f (T ditulis eksplisit)\n", collections.Sum[float64](1, 2))
+	fmt.Printf("Max(3, 7)          : %d\n", Max(3, 7))
+	fmt.Printf("Max(\"Ani\", \"Budi\") : %s\n", Max("Ani", "Budi"))
+	// collections.Sum("a", "b") // ❌ compile error: string does not satisfy Number
+
+	// =============================================================================
+	// 3. SWAP GENERIC
+	// =============================================================================
+	fmt.Println("\n--- 3. Swap Generic ---")
+
+	a, b := 10, 20
+	collections.Swap(&a, &b)
+	fmt.Printf("Swap int   : a=%d, b=%d\n", a, b)
+
+	s1, s2 := "kiri", "kanan"
+	collections.Swap(&s1, &s2)
+	fmt.Printf("Swap string: s1=%s, s2=%s\n", s1, s2)
+
+	p1, p2 := Person{"Budi", 25}, Person{"Ani", 22}
+	collections.Swap(&p1, &p2)
+	fmt.Printf("Swap Person: p1=%v, p2=%v\n", p1, p2)
+
+	// =============================================================================
+	// 4. DeleteAt: S ~[]E
+	// =============================================================================
+	// Trik pelajaran 06 dijadikan fungsi. Hasilnya tetap bertipe Names, bukan []string
+	fmt.Println("\n--- 4. DeleteAt ---")
+
+	names := Names{"Alice", "Bob", "Charlie", "David", "Eve"}
+	names = collections.DeleteAt(names, 2)
+	fmt.Printf("Setelah DeleteAt(2): %v (tipe %T)\n", names, names)
+
+	angka := []float64{1.1, 2.2, 3.3}
+	angka = collections.DeleteAt(angka, 0)
+	fmt.Printf("DeleteAt float64   : %v\n", angka)
+
+	// =============================================================================
+	// 5. MAP, FILTER, REDUCE
+	// =============================================================================
+	fmt.Println("\n--- 5. Map, Filter, Reduce ---")
+
+	students := []Person{
+		{Nama: "Eka Putri", Umur: 20},
+		{Nama: "Fani Nugraha", Umur: 17},
+		{Nama: "Gilang Ramadhan", Umur: 19},
+		{Nama: "Hana Lestari", Umur: 16},
+	}
+
+	// Map: []Person -> []string
+	namaSaja := collections.Map(students, func(p Person) string { return p.Nama })
+	fmt.Printf("Map (nama)       : %v\n", namaSaja)
+
+	// Filter: hanya yang sudah dewasa (IsAdult dari pelajaran 09)
+	dewasa := collections.Filter(students, func(p Person) bool { return p.Umur >= 18 })
+	fmt.Printf("Filter (dewasa)  : %v\n", collections.Map(dewasa, func(p Person) string { return p.Nama }))
+
+	// Reduce: jumlahkan umur, tipe akumulator (int) berbeda dari elemen (Person)
+	totalUmur := collections.Reduce(students, 0, func(acc int, p Person) int { return acc + p.Umur })
+	fmt.Printf("Reduce (umur)    : total %d, rata-rata %.2f\n", totalUmur, float64(totalUmur)/float64(len(students)))
+
+	// Ketiganya bisa dirangkai
+	inisial := collections.Reduce(
+		collections.Map(students, func(p Person) string { return p.Nama[:1] }),
+		"",
+		func(acc, s string) string { return acc + s },
+	)
+	fmt.Printf("Rangkaian        : inisial %s\n", inisial)
+
+	// =============================================================================
+	// 6. KEYS DAN VALUES UNTUK MAP
+	// =============================================================================
+	fmt.Println("\n--- 6. Keys dan Values ---")
+
+	nilaiMapel := map[string]int{
+		"matematika": 90,
+		"fisika":     85,
+		"kimia":      88,
+		"biologi":    92,
+	}
+	fmt.Printf("Jumlah key       : %d (urutan Keys acak)\n", len(collections.Keys(nilaiMapel)))
+	fmt.Printf("SortedKeys       : %v\n", collections.SortedKeys(nilaiMapel))
+	fmt.Printf("Total Values     : %d\n", collections.Sum(collections.Values(nilaiMapel)...))
+
+	// =============================================================================
+	// 7. STRUCT GENERIC
+	// =============================================================================
+	fmt.Println("\n--- 7. Struct Generic ---")
+
+	pairs := collections.Map(collections.SortedKeys(nilaiMapel), func(k string) Pair[string, int] {
+		return Pair[string, int]{Key: k, Value: nilaiMapel[k]}
+	})
+	fmt.Printf("Pairs: %v\n", pairs)
+
+	// =============================================================================
+	// 8. PERBANDINGAN DENGAN slices DAN maps
+	// =============================================================================
+	// Standard library sudah punya banyak helper generic
+	fmt.Println("\n--- 8. Perbandingan dengan slices dan maps ---")
+
+	daftar := []string{"Alice", "Bob", "Charlie", "David", "Eve"}
+
+	ours := collections.DeleteAt(slices.Clone(daftar), 2)
+	std := slices.Delete(slices.Clone(daftar), 2, 3) // Hapus index [2, 3)
+	fmt.Printf("collections.DeleteAt : %v\n", ours)
+	fmt.Printf("slices.Delete        : %v\n", std)
+	fmt.Printf("Hasil sama           : %v\n", slices.Equal(ours, std))
+
+	fmt.Printf("collections.SortedKeys     : %v\n", collections.SortedKeys(nilaiMapel))
+	fmt.Printf("slices.Sorted(maps.Keys()) : %v\n", slices.Sorted(maps.Keys(nilaiMapel)))
+
+	// Helper lain yang sudah tersedia
+	fmt.Printf("slices.Contains(Bob)  : %v\n", slices.Contains(daftar, "Bob"))
+	fmt.Printf("slices.Index(David)   : %d\n", slices.Index(daftar, "David"))
+	fmt.Printf("slices.Max(nilai)     : %d\n", slices.Max(nilai))
+	fmt.Printf("slices.IndexFunc(>=5) : %d\n", slices.IndexFunc(daftar, func(s string) bool { return len(s) >= 5 }))
+	fmt.Printf("strings dari Map      : %s\n", strings.Join(collections.Map(nilai, func(n int) string { return fmt.Sprint(n) }), ", "))
+
+	fmt.Println("\nBelum ada di standard library (per Go 1.25): Map, Filter, Reduce untuk slice")
+	fmt.Println("Sudah ada: Delete, Contains, Index, Max, Min, Sort, Keys, Values, dll")
+
+	// =============================================================================
+	// 9. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 9. Best Practices Generics ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Gunakan generics untuk kode yang IDENTIK untuk banyak tipe")
+	fmt.Println("   - Pakai constraint sekecil mungkin (any jika cukup)")
+	fmt.Println("   - Pakai ~ agar tipe buatan sendiri juga didukung")
+	fmt.Println("   - Cek slices dan maps sebelum membuat helper sendiri")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Memakai generics jika interface biasa sudah cukup")
+	fmt.Println("   - Membuat type parameter yang hanya dipakai sekali")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Generics: tulis sekali, pakai untuk banyak tipe")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,42 @@
+package calc
+
+import (
+	"fmt"
+	"testing"
+)
+
+// =============================================================================
+// 5. BENCHMARK
+// =============================================================================
+// Jalankan dengan: go test -bench=. -benchmem
+// b.Loop() (Go 1.24+) menjalankan body sebanyak yang diperlukan untuk
+// mendapatkan waktu per operasi yang stabil.
+
+func BenchmarkFactorial(b *testing.B) {
+	for b.Loop() {
+		Factorial(20)
+	}
+}
+
+// Sub-benchmark untuk membandingkan beberapa ukuran input
+func BenchmarkFactorialSizes(b *testing.B) {
+	for _, n := range []int{5, 10, 20} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for b.Loop() {
+				Factorial(n)
+			}
+		})
+	}
+}
+
+func BenchmarkLaporan(b *testing.B) {
+	people := []Person{
+		{Nama: "Budi Santoso", Umur: 25},
+		{Nama: "Ani Wijaya", Umur: 17},
+		{Nama: "Caca Handika", Umur: 18},
+	}
+	b.ReportAllocs() // Tampilkan alokasi memory per operasi
+	for b.Loop() {
+		Laporan(people)
+	}
+}
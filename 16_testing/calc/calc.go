@@ -0,0 +1,87 @@
+// Package calc berisi fungsi-fungsi dari pelajaran sebelumnya yang dipindahkan
+// ke package tersendiri agar bisa diuji dengan go test.
+//
+// Sumber fungsi:
+//   - Divide, ValidateAge, ValidationError dari pelajaran 11
+//   - Factorial dan HitungLuasKeliling dari pelajaran 08
+//   - Person dan IsAdult dari pelajaran 09
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDivideByZero dikembalikan Divide jika pembagi nol
+var ErrDivideByZero = errors.New("tidak bisa membagi dengan nol")
+
+// Divide membagi dua angka dengan integer division
+func Divide(a, b int) (int, error) {
+	if b == 0 {
+		return 0, ErrDivideByZero
+	}
+	return a / b, nil
+}
+
+// ValidationError adalah custom error untuk validasi input
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("validasi gagal pada field '%s': %s", e.Field, e.Message)
+}
+
+// ValidateAge memvalidasi umur: tidak boleh negatif dan maksimal 150
+func ValidateAge(age int) error {
+	if age < 0 {
+		return ValidationError{Field: "age", Message: "umur tidak boleh negatif"}
+	}
+	if age > 150 {
+		return ValidationError{Field: "age", Message: "umur terlalu tinggi (maksimal 150)"}
+	}
+	return nil
+}
+
+// Factorial menghitung n! secara rekursif, n <= 1 menghasilkan 1
+func Factorial(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * Factorial(n-1)
+}
+
+// HitungLuasKeliling menghitung luas dan keliling persegi panjang
+func HitungLuasKeliling(panjang, lebar int) (luas int, keliling int) {
+	luas = panjang * lebar
+	keliling = 2 * (panjang + lebar)
+	return
+}
+
+// Person merepresentasikan data seseorang
+type Person struct {
+	Nama string
+	Umur int
+}
+
+// IsAdult mengembalikan true jika umur 18 tahun ke atas
+func (p Person) IsAdult() bool {
+	return p.Umur >= 18
+}
+
+// Laporan membuat tabel teks berisi nama, umur, dan status dewasa
+// Output multi-baris seperti ini cocok diuji dengan golden file
+func Laporan(people []Person) string {
+	var sb strings.Builder
+	sb.WriteString("No  Nama              Umur  Status\n")
+	for i, p := range people {
+		status := "Belum dewasa"
+		if p.IsAdult() {
+			status = "Dewasa"
+		}
+		fmt.Fprintf(&sb, "%-3d %-17s %4d  %s\n", i+1, p.Nama, p.Umur, status)
+	}
+	return sb.String()
+}
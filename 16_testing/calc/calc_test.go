@@ -0,0 +1,171 @@
+package calc
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// =============================================================================
+// 1. TEST SEDERHANA
+// =============================================================================
+// Nama fungsi test: TestXxx(t *testing.T), berada di file *_test.go
+
+func TestFactorial(t *testing.T) {
+	got := Factorial(5)
+	if got != 120 {
+		// t.Errorf mencatat kegagalan tapi test tetap lanjut
+		// t.Fatalf mencatat kegagalan lalu menghentikan test ini
+		t.Errorf("Factorial(5) = %d, want 120", got)
+	}
+}
+
+// =============================================================================
+// 2. TABLE-DRIVEN TEST DAN SUBTEST
+// =============================================================================
+// Semua kasus ditulis sebagai tabel, lalu dijalankan dengan t.Run (subtest).
+// Subtest bisa dijalankan sendiri: go test -run 'TestDivide/bagi_nol'
+
+func TestDivide(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    int
+		want    int
+		wantErr error
+	}{
+		{name: "bagi habis", a: 10, b: 2, want: 5},
+		{name: "pembulatan ke bawah", a: 7, b: 2, want: 3},
+		{name: "angka negatif", a: -9, b: 3, want: -3},
+		{name: "bagi nol", a: 10, b: 0, wantErr: ErrDivideByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel() // Subtest berjalan bersamaan dengan subtest lain
+
+			got, err := Divide(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Divide(%d, %d) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			assertEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestValidateAge(t *testing.T) {
+	tests := []struct {
+		name      string
+		age       int
+		wantValid bool
+	}{
+		{name: "nol", age: 0, wantValid: true},
+		{name: "normal", age: 25, wantValid: true},
+		{name: "batas atas", age: 150, wantValid: true},
+		{name: "negatif", age: -1, wantValid: false},
+		{name: "terlalu tinggi", age: 151, wantValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateAge(tt.age)
+			if tt.wantValid {
+				if err != nil {
+					t.Fatalf("ValidateAge(%d) = %v, want nil", tt.age, err)
+				}
+				return
+			}
+
+			// errors.As memastikan tipe error-nya benar, bukan hanya "ada error"
+			var validationErr ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ValidateAge(%d) = %v, want ValidationError", tt.age, err)
+			}
+			assertEqual(t, validationErr.Field, "age")
+		})
+	}
+}
+
+func TestHitungLuasKeliling(t *testing.T) {
+	tests := []struct {
+		panjang, lebar int
+		luas, keliling int
+	}{
+		{5, 3, 15, 16},
+		{1, 1, 1, 4},
+		{0, 10, 0, 20},
+	}
+
+	for _, tt := range tests {
+		luas, keliling := HitungLuasKeliling(tt.panjang, tt.lebar)
+		assertEqual(t, luas, tt.luas)
+		assertEqual(t, keliling, tt.keliling)
+	}
+}
+
+func TestIsAdult(t *testing.T) {
+	tests := map[string]struct {
+		umur int
+		want bool
+	}{
+		"anak-anak": {umur: 10, want: false},
+		"tepat 17":  {umur: 17, want: false},
+		"tepat 18":  {umur: 18, want: true},
+		"orang tua": {umur: 60, want: true},
+	}
+
+	// Tabel juga bisa berupa map: nama kasus menjadi key
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assertEqual(t, Person{Nama: name, Umur: tt.umur}.IsAdult(), tt.want)
+		})
+	}
+}
+
+// =============================================================================
+// 3. HELPER DENGAN t.Helper
+// =============================================================================
+
+// assertEqual membandingkan dua nilai comparable
+// t.Helper() membuat pesan error menunjuk ke baris PEMANGGIL, bukan ke baris ini
+func assertEqual[T comparable](t *testing.T, got, want T) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// =============================================================================
+// 4. GOLDEN FILE
+// =============================================================================
+// Output panjang dibandingkan dengan file di testdata/ (folder yang diabaikan
+// oleh go build). Perbarui golden file dengan: go test -run TestLaporan -update
+
+var update = flag.Bool("update", false, "perbarui golden file di testdata/")
+
+func TestLaporan(t *testing.T) {
+	people := []Person{
+		{Nama: "Budi Santoso", Umur: 25},
+		{Nama: "Ani Wijaya", Umur: 17},
+		{Nama: "Caca Handika", Umur: 18},
+	}
+	got := Laporan(people)
+
+	golden := filepath.Join("testdata", "laporan.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("gagal membaca golden file: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("Laporan() tidak sama dengan %s\ngot:\n%s\nwant:\n%s", golden, got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package calc_test
+
+import (
+	"fmt"
+
+	"learn-go/16_testing/calc"
+)
+
+// =============================================================================
+// 7. EXAMPLE TEST
+// =============================================================================
+// Example adalah dokumentasi yang sekaligus diuji: go test membandingkan
+// output dengan komentar "// Output:". Example juga muncul di go doc.
+// Package calc_test (akhiran _test) menguji dari luar, hanya lewat API publik.
+
+func ExampleDivide() {
+	hasil, err := calc.Divide(10, 3)
+	fmt.Println(hasil, err)
+
+	_, err = calc.Divide(10, 0)
+	fmt.Println(err)
+	// Output:
+	// 3 <nil>
+	// tidak bisa membagi dengan nol
+}
+
+func ExampleFactorial() {
+	fmt.Println(calc.Factorial(5))
+	// Output: 120
+}
+
+func ExampleHitungLuasKeliling() {
+	luas, keliling := calc.HitungLuasKeliling(5, 3)
+	fmt.Printf("luas=%d keliling=%d\n", luas, keliling)
+	// Output: luas=15 keliling=16
+}
+
+func ExamplePerson_IsAdult() {
+	budi := calc.Person{Nama: "Budi", Umur: 18}
+	fmt.Println(budi.IsAdult())
+	// Output: true
+}
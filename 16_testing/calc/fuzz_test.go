@@ -0,0 +1,55 @@
+package calc
+
+import (
+	"errors"
+	"testing"
+)
+
+// =============================================================================
+// 6. FUZZING
+// =============================================================================
+// Fuzzing menghasilkan input acak untuk mencari kasus yang membuat fungsi
+// panic atau melanggar sifat (property) yang seharusnya selalu benar.
+// Jalankan dengan: go test -fuzz=FuzzDivide -fuzztime=10s
+// Tanpa -fuzz, go test hanya menjalankan seed corpus (f.Add) sebagai test biasa.
+// Input yang menyebabkan gagal disimpan di testdata/fuzz/ untuk diulang.
+
+func FuzzDivide(f *testing.F) {
+	// Seed corpus: contoh input awal
+	f.Add(10, 2)
+	f.Add(7, 0)
+	f.Add(-9, 3)
+
+	f.Fuzz(func(t *testing.T, a, b int) {
+		// Divide tidak boleh panic untuk input apa pun
+		got, err := Divide(a, b)
+
+		if b == 0 {
+			if !errors.Is(err, ErrDivideByZero) {
+				t.Fatalf("Divide(%d, 0) error = %v, want ErrDivideByZero", a, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Divide(%d, %d) error tak terduga: %v", a, b, err)
+		}
+		// Property: a == hasil*b + sisa
+		if got*b+a%b != a {
+			t.Fatalf("Divide(%d, %d) = %d melanggar a == q*b + a%%b", a, b, got)
+		}
+	})
+}
+
+func FuzzValidateAge(f *testing.F) {
+	f.Add(0)
+	f.Add(-1)
+	f.Add(151)
+
+	f.Fuzz(func(t *testing.T, age int) {
+		err := ValidateAge(age)
+		valid := age >= 0 && age <= 150
+		if valid != (err == nil) {
+			t.Fatalf("ValidateAge(%d) = %v, valid seharusnya %v", age, err, valid)
+		}
+	})
+}
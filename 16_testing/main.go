@@ -0,0 +1,154 @@
+/*
+================================================================================
+PELAJARAN 16: TESTING
+================================================================================
+
+TESTING DI GO
+-------------
+Go punya tool testing bawaan: go test. Tidak perlu library tambahan.
+- File test berakhiran _test.go (tidak ikut di-build ke program)
+- Fungsi test diawali Test, Benchmark, Fuzz, atau Example
+- Package testing menyediakan *testing.T, *testing.B, *testing.F
+
+Materi ini memakai package calc (folder calc/) yang berisi fungsi dari
+pelajaran sebelumnya: Divide, ValidateAge (11), Factorial, HitungLuasKeliling
+(08), dan Person.IsAdult (09). Buka file-file test di folder calc/:
+
+┌──────────────────────┬──────────────────────────────────────────────┐
+│ File                 │ Isi                                          │
+├──────────────────────┼──────────────────────────────────────────────┤
+│ calc_test.go         │ Test dasar, table-driven, subtest, t.Helper, │
+│                      │ t.Parallel, golden file                      │
+│ bench_test.go        │ Benchmark dengan testing.B                   │
+│ fuzz_test.go         │ Fuzzing dengan testing.F                     │
+│ example_test.go      │ Example test yang muncul di go doc           │
+│ testdata/            │ Golden file (diabaikan oleh go build)        │
+└──────────────────────┴──────────────────────────────────────────────┘
+
+JENIS FUNGSI TEST
+-----------------
+┌──────────────────────────────────────────┬───────────────────────────────┐
+│ Signature                                │ Kegunaan                      │
+├──────────────────────────────────────────┼───────────────────────────────┤
+│ func TestXxx(t *testing.T)               │ Unit test                     │
+│ func BenchmarkXxx(b *testing.B)          │ Mengukur performa             │
+│ func FuzzXxx(f *testing.F)               │ Mencari bug dengan input acak │
+│ func ExampleXxx()                        │ Dokumentasi yang diuji        │
+└──────────────────────────────────────────┴───────────────────────────────┘
+
+PERINTAH PENTING (jalankan dari folder 16_testing)
+--------------------------------------------------
+go test ./calc                              - Jalankan semua test
+go test -v ./calc                           - Tampilkan setiap test
+go test -run TestDivide ./calc              - Hanya test tertentu (regex)
+go test -run 'TestDivide/bagi_nol' ./calc   - Hanya satu subtest
+go test -run TestLaporan -update ./calc     - Perbarui golden file
+go test -bench=. -benchmem ./calc           - Jalankan benchmark
+go test -fuzz=FuzzDivide -fuzztime=10s ./calc - Jalankan fuzzing
+go test -cover ./calc                       - Persentase coverage
+go test -coverprofile=cover.out ./calc      - Simpan profil coverage
+go tool cover -html=cover.out               - Lihat coverage per baris
+go test -race ./calc                        - Test dengan race detector
+go doc -all ./calc                          - Dokumentasi + Example
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"learn-go/16_testing/calc"
+)
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("TESTING")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. FUNGSI YANG DIUJI
+	// =============================================================================
+	// Program ini hanya memanggil fungsi-fungsinya. Pengujiannya ada di calc/*_test.go
+	fmt.Println("--- 1. Fungsi yang Diuji (package calc) ---")
+
+	hasil, err := calc.Divide(10, 2)
+	fmt.Printf("Divide(10, 2)           = %d, %v\n", hasil, err)
+	_, err = calc.Divide(10, 0)
+	fmt.Printf("Divide(10, 0)           = error: %v\n", err)
+	fmt.Printf("ValidateAge(-5)         = %v\n", calc.ValidateAge(-5))
+	fmt.Printf("Factorial(5)            = %d\n", calc.Factorial(5))
+	luas, keliling := calc.HitungLuasKeliling(5, 3)
+	fmt.Printf("HitungLuasKeliling(5,3) = %d, %d\n", luas, keliling)
+	fmt.Printf("Person{Umur: 17}.IsAdult = %v\n", calc.Person{Nama: "Ani", Umur: 17}.IsAdult())
+
+	// =============================================================================
+	// 2. ANATOMI TABLE-DRIVEN TEST
+	// =============================================================================
+	fmt.Println("\n--- 2. Table-Driven Test ---")
+	fmt.Println(`tests := []struct {
+    name    string
+    a, b    int
+    want    int
+    wantErr error
+}{
+    {name: "bagi habis", a: 10, b: 2, want: 5},
+    {name: "bagi nol", a: 10, b: 0, wantErr: ErrDivideByZero},
+}
+for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+        got, err := Divide(tt.a, tt.b)
+        ...
+    })
+}`)
+
+	// =============================================================================
+	// 3. GOLDEN FILE
+	// =============================================================================
+	// Output Laporan dibandingkan dengan calc/testdata/laporan.golden
+	fmt.Println("\n--- 3. Output yang Diuji dengan Golden File ---")
+
+	fmt.Print(calc.Laporan([]calc.Person{
+		{Nama: "Budi Santoso", Umur: 25},
+		{Nama: "Ani Wijaya", Umur: 17},
+		{Nama: "Caca Handika", Umur: 18},
+	}))
+
+	// =============================================================================
+	// 4. CARA MENJALANKAN
+	// =============================================================================
+	fmt.Println("\n--- 4. Cara Menjalankan ---")
+
+	commands := []struct{ cmd, desc string }{
+		{"go test ./calc", "semua test, benchmark tidak ikut"},
+		{"go test -v -run TestDivide ./calc", "test tertentu dengan detail"},
+		{"go test -bench=. -benchmem ./calc", "benchmark + alokasi memory"},
+		{"go test -fuzz=FuzzDivide -fuzztime=10s ./calc", "fuzzing selama 10 detik"},
+		{"go test -cover ./calc", "persentase coverage"},
+		{"go doc -all ./calc", "dokumentasi beserta Example"},
+	}
+	for _, c := range commands {
+		fmt.Printf("  %-46s # %s\n", c.cmd, c.desc)
+	}
+
+	// =============================================================================
+	// 5. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 5. Best Practices Testing ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Gunakan table-driven test untuk banyak kasus")
+	fmt.Println("   - Beri nama subtest yang jelas agar mudah dijalankan sendiri")
+	fmt.Println("   - Panggil t.Helper() di fungsi helper")
+	fmt.Println("   - Uji kasus error, bukan hanya kasus sukses")
+	fmt.Println("   - Simpan data test di folder testdata/")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Membuat test yang bergantung pada urutan test lain")
+	fmt.Println("   - Mengejar coverage 100% tanpa memikirkan kasus penting")
+	fmt.Println("   - Memakai time.Sleep untuk menunggu goroutine di test")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Jalankan: go test -v ./calc")
+	fmt.Println("================================================================================")
+}
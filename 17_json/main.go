@@ -0,0 +1,550 @@
+/*
+================================================================================
+PELAJARAN 17: JSON ENCODING DAN DECODING
+================================================================================
+
+APA ITU JSON?
+-------------
+JSON (JavaScript Object Notation) adalah format teks untuk pertukaran data,
+dipakai hampir semua API web. Package encoding/json mengubah:
+- struct Go → JSON  : json.Marshal   (encoding)
+- JSON → struct Go  : json.Unmarshal (decoding)
+
+Hanya field EXPORTED (huruf awal besar) yang ikut di-encode/decode.
+
+STRUCT TAG
+----------
+Tag adalah metadata di belakang field, ditulis dengan backtick:
+
+type Product struct {
+    Nama  string  `json:"nama"`            // nama key di JSON
+    Stok  int     `json:"stok,omitempty"`  // dilewati jika zero value
+    Kode  string  `json:"-"`               // tidak pernah ikut JSON
+}
+
+┌──────────────────────┬────────────────────────────────────────────┐
+│ Tag                  │ Arti                                       │
+├──────────────────────┼────────────────────────────────────────────┤
+│ json:"nama"          │ Key JSON menjadi "nama"                    │
+│ json:"nama,omitempty"│ Lewati field jika zero value / nil / kosong│
+│ json:"-"             │ Field diabaikan                            │
+│ json:",string"       │ Angka/bool ditulis sebagai string          │
+└──────────────────────┴────────────────────────────────────────────┘
+
+CUSTOM MARSHALER
+----------------
+Tipe bisa mengatur bentuk JSON-nya sendiri dengan method:
+- MarshalJSON() ([]byte, error)
+- UnmarshalJSON([]byte) error
+
+STREAMING
+---------
+json.Marshal/Unmarshal memproses SELURUH data sekaligus di memory.
+json.Encoder/json.Decoder membaca/menulis langsung dari io.Reader/io.Writer,
+cocok untuk file besar atau HTTP body.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// =============================================================================
+// STRUCT DARI PELAJARAN 09 (TANPA TAG)
+// =============================================================================
+
+// ProductTanpaTag adalah Product persis seperti di pelajaran 09
+type ProductTanpaTag struct {
+	Nama     string
+	Harga    float64
+	Stok     int
+	Tersedia bool
+}
+
+// =============================================================================
+// TIPE RUPIAH DENGAN CUSTOM MARSHALER
+// =============================================================================
+
+// Rupiah menyimpan harga dalam float64, tapi di JSON ditulis "Rp15.000.000"
+type Rupiah float64
+
+// String memformat angka dengan pemisah ribuan titik dan desimal koma,
+// gaya Indonesia: 15000000 → "Rp15.000.000", 1250.5 → "Rp1.250,50".
+// Nilai dibulatkan ke 2 desimal (sen); ",00" tidak ditulis.
+func (r Rupiah) String() string {
+	angka := strconv.FormatFloat(float64(r), 'f', 2, 64)
+	negatif := strings.HasPrefix(angka, "-")
+	angka = strings.TrimPrefix(angka, "-")
+	digits, sen, _ := strings.Cut(angka, ".")
+
+	var sb strings.Builder
+	for i, d := range digits {
+		// Sisipkan titik setiap 3 digit dari kanan
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteRune(d)
+	}
+	if sen != "00" {
+		sb.WriteString("," + sen)
+	}
+
+	// Nilai seperti -0.001 dibulatkan menjadi 0: tulis "Rp0", bukan "-Rp0"
+	if negatif && strings.Trim(sb.String(), "0.,") != "" {
+		return "-Rp" + sb.String()
+	}
+	return "Rp" + sb.String()
+}
+
+// MarshalJSON menulis Rupiah sebagai string JSON, misal "Rp15.000.000"
+func (r Rupiah) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON menerima dua bentuk: string "Rp15.000.000" atau angka 15000000.
+// Bentuk string adalah kebalikan String(), termasuk tanda minus di depan "Rp"
+// ("-Rp50.000") dan desimal koma ("Rp1.250,50").
+func (r *Rupiah) UnmarshalJSON(data []byte) error {
+	var angka float64
+	if err := json.Unmarshal(data, &angka); err == nil {
+		*r = Rupiah(angka)
+		return nil
+	}
+
+	// Error dikembalikan sebagai ValidationError (didefinisikan di bawah)
+	var teks string
+	if err := json.Unmarshal(data, &teks); err != nil {
+		return ValidationError{Field: "harga", Message: "harus berupa angka atau string Rupiah"}
+	}
+	// Tanda minus dilepas dulu, karena letaknya SEBELUM "Rp"
+	bersih, negatif := strings.CutPrefix(teks, "-")
+	bersih, adaRp := strings.CutPrefix(bersih, "Rp")
+	digit, ok := normalisasiRupiah(bersih)
+	if !adaRp || !ok {
+		return ValidationError{Field: "harga", Message: fmt.Sprintf("format Rupiah tidak valid: %q", teks)}
+	}
+	// digit hanya berisi 0-9 dan satu titik desimal, jadi ParseFloat tidak
+	// mungkin menerima NaN, Inf, atau eksponen
+	nilai, err := strconv.ParseFloat(digit, 64)
+	if err != nil {
+		return ValidationError{Field: "harga", Message: fmt.Sprintf("format Rupiah tidak valid: %q", teks)}
+	}
+	if negatif {
+		nilai = -nilai
+	}
+	*r = Rupiah(nilai)
+	return nil
+}
+
+// normalisasiRupiah mengubah "1.250,50" menjadi "1250.50" untuk ParseFloat.
+// Bagian bulat boleh tanpa pemisah ("15000") atau dikelompokkan per 3 digit
+// dengan titik ("15.000"); sen setelah koma 1-2 digit. Bentuk lain seperti
+// "1.2.3", "NaN", atau "1e5" ditolak (ok = false).
+func normalisasiRupiah(s string) (angka string, ok bool) {
+	bulat, sen, adaKoma := strings.Cut(s, ",")
+	if adaKoma && (len(sen) < 1 || len(sen) > 2 || !semuaDigit(sen)) {
+		return "", false
+	}
+
+	grup := strings.Split(bulat, ".")
+	for i, g := range grup {
+		switch {
+		case !semuaDigit(g):
+			return "", false
+		case i == 0 && len(grup) > 1 && len(g) > 3:
+			return "", false // Grup pertama 1-3 digit: "1.000", bukan "1000.000"
+		case i > 0 && len(g) != 3:
+			return "", false // Grup berikutnya tepat 3 digit
+		}
+	}
+	if len(bulat) > 1 && bulat[0] == '0' {
+		return "", false // "007" atau "0.500" bukan format Rupiah
+	}
+
+	angka = strings.Join(grup, "")
+	if adaKoma {
+		angka += "." + sen
+	}
+	return angka, true
+}
+
+// semuaDigit mengecek s tidak kosong dan hanya berisi 0-9
+func semuaDigit(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// =============================================================================
+// STRUCT DENGAN TAG
+// =============================================================================
+
+// Product dengan struct tag untuk JSON
+type Product struct {
+	SKU       string `json:"sku"`
+	Nama      string `json:"nama"`
+	Harga     Rupiah `json:"harga"` // Memakai Rupiah.MarshalJSON
+	Stok      int    `json:"stok"`  // Tanpa omitempty: stok 0 tetap ditulis
+	Tersedia  bool   `json:"tersedia"`
+	Catatan   string `json:"catatan,omitempty"` // Dilewati jika string kosong
+	HargaBeli Rupiah `json:"-"`                 // Rahasia: tidak pernah ikut JSON
+}
+
+// Address dengan tag snake_case
+type Address struct {
+	Jalan   string `json:"jalan"`
+	Kota    string `json:"kota"`
+	KodePos string `json:"kode_pos"`
+}
+
+// Employee dengan nested struct Address
+// AlamatKantor memakai pointer + omitempty: nil berarti tidak ada, dilewati
+type Employee struct {
+	Nama         string   `json:"nama"`
+	Umur         int      `json:"umur"`
+	Address      Address  `json:"alamat"`
+	AlamatKantor *Address `json:"alamat_kantor,omitempty"`
+	Keahlian     []string `json:"keahlian,omitempty"`
+}
+
+// =============================================================================
+// VALIDASI (ValidationError DARI PELAJARAN 11)
+// =============================================================================
+
+// ValidationError sama seperti di pelajaran 11
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("validasi gagal pada field '%s': %s", e.Field, e.Message)
+}
+
+// Validate memeriksa aturan bisnis yang tidak bisa dicek oleh encoding/json
+func (p Product) Validate() error {
+	switch {
+	case p.SKU == "":
+		return ValidationError{Field: "sku", Message: "wajib diisi"}
+	case p.Nama == "":
+		return ValidationError{Field: "nama", Message: "wajib diisi"}
+	case p.Harga < 0:
+		return ValidationError{Field: "harga", Message: "tidak boleh negatif"}
+	case p.Stok < 0:
+		return ValidationError{Field: "stok", Message: "tidak boleh negatif"}
+	}
+	return nil
+}
+
+// toValidationError mengubah error dari encoding/json menjadi ValidationError
+// agar pemanggil cukup menangani satu jenis error untuk input yang salah
+func toValidationError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return ValidationError{
+			Field:   typeErr.Field,
+			Message: fmt.Sprintf("harus bertipe %s, bukan %s", typeErr.Type, typeErr.Value),
+		}
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return ValidationError{
+			Field:   "(json)",
+			Message: fmt.Sprintf("format JSON rusak di byte %d", syntaxErr.Offset),
+		}
+	}
+
+	// DisallowUnknownFields menghasilkan error biasa: json: unknown field "x"
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return ValidationError{Field: strings.Trim(field, `"`), Message: "field tidak dikenal"}
+	}
+	return err
+}
+
+// DecodeProduct membaca satu Product dari JSON secara ketat:
+// field tak dikenal ditolak, tipe salah dan aturan bisnis menjadi ValidationError
+func DecodeProduct(r io.Reader) (Product, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	var p Product
+	if err := dec.Decode(&p); err != nil {
+		return Product{}, toValidationError(err)
+	}
+	if err := p.Validate(); err != nil {
+		return Product{}, err
+	}
+	return p, nil
+}
+
+// =============================================================================
+// STREAMING DECODER
+// =============================================================================
+
+// katalogJSON adalah contoh isi file katalog produk (array JSON)
+const katalogJSON = `[
+  {"sku": "P001", "nama": "Laptop Gaming", "harga": "Rp15.000.000", "stok": 10, "tersedia": true},
+  {"sku": "P002", "nama": "Mouse Wireless", "harga": 250000, "stok": 50, "tersedia": true},
+  {"sku": "P003", "nama": "Headset", "harga": "Rp500.000", "stok": 0, "tersedia": false},
+  {"sku": "P004", "nama": "", "harga": "Rp100.000", "stok": 5, "tersedia": true},
+  {"sku": "P005", "nama": "Keyboard", "harga": "Rp750.000", "stok": "banyak", "tersedia": true},
+  {"sku": "P006", "nama": "Monitor", "harga": "Rp2.500.000", "stok": 7, "tersedia": true, "warna": "hitam"}
+]`
+
+// StreamKatalog membaca array produk SATU PER SATU tanpa memuat seluruh file
+// Produk yang valid dikirim ke fungsi onProduct, produk yang tidak valid
+// dikumpulkan sebagai error (lanjut ke produk berikutnya)
+func StreamKatalog(r io.Reader, onProduct func(Product)) ([]error, error) {
+	dec := json.NewDecoder(r)
+
+	// Token pertama harus '[' (awal array)
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("katalog harus berupa array JSON, bukan %v", tok)
+	}
+
+	var invalid []error
+	for i := 1; dec.More(); i++ {
+		// Decode satu elemen ke json.RawMessage dulu, agar elemen yang salah
+		// tidak merusak pembacaan elemen berikutnya
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return invalid, err
+		}
+
+		p, err := DecodeProduct(strings.NewReader(string(raw)))
+		if err != nil {
+			invalid = append(invalid, fmt.Errorf("produk #%d: %w", i, err))
+			continue
+		}
+		onProduct(p)
+	}
+
+	// Token terakhir: ']'
+	if _, err := dec.Token(); err != nil {
+		return invalid, err
+	}
+	return invalid, nil
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("JSON ENCODING DAN DECODING")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. MARSHAL TANPA TAG
+	// =============================================================================
+	// Nama key JSON sama persis dengan nama field Go
+	fmt.Println("--- 1. Marshal Tanpa Tag ---")
+
+	lama := ProductTanpaTag{Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true}
+	fmt.Printf("%%+v  : %+v\n", lama)
+	data, err := json.Marshal(lama)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+	fmt.Printf("JSON : %s\n", data)
+
+	// =============================================================================
+	// 2. STRUCT TAG, omitempty, DAN "-"
+	// =============================================================================
+	fmt.Println("\n--- 2. Struct Tag ---")
+
+	laptop := Product{
+		SKU:       "P001",
+		Nama:      "Laptop Gaming",
+		Harga:     15000000,
+		Stok:      10,
+		Tersedia:  true,
+		HargaBeli: 12000000, // Tidak akan muncul di JSON
+	}
+	data, _ = json.Marshal(laptop)
+	fmt.Printf("Catatan kosong  : %s\n", data)
+
+	laptop.Catatan = "Garansi 2 tahun"
+	data, _ = json.Marshal(laptop)
+	fmt.Printf("Catatan terisi  : %s\n", data)
+
+	// =============================================================================
+	// 3. CUSTOM MarshalJSON: HARGA SEBAGAI RUPIAH
+	// =============================================================================
+	fmt.Println("\n--- 3. Custom MarshalJSON (Rupiah) ---")
+
+	for _, harga := range []Rupiah{250000, 15000000, 999, -50000, 1250.5} {
+		data, _ := json.Marshal(harga)
+		// Unmarshal balik harus menghasilkan nilai yang sama (round-trip)
+		var kembali Rupiah
+		if err := json.Unmarshal(data, &kembali); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			continue
+		}
+		fmt.Printf("Rupiah(%s) → %s → %s\n",
+			strconv.FormatFloat(float64(harga), 'f', -1, 64), data,
+			strconv.FormatFloat(float64(kembali), 'f', -1, 64))
+	}
+
+	// =============================================================================
+	// 4. NESTED STRUCT DAN MarshalIndent
+	// =============================================================================
+	fmt.Println("\n--- 4. Nested Struct (Employee + Address) ---")
+
+	employee := Employee{
+		Nama: "Doni Pratama",
+		Umur: 28,
+		Address: Address{
+			Jalan:   "Jl. Gatot Subroto Kav. 12",
+			Kota:    "Jakarta Selatan",
+			KodePos: "12930",
+		},
+		Keahlian: []string{"Go", "SQL"},
+		// AlamatKantor nil → dilewati karena omitempty
+	}
+	data, _ = json.MarshalIndent(employee, "", "  ")
+	fmt.Println(string(data))
+
+	// =============================================================================
+	// 5. UNMARSHAL
+	// =============================================================================
+	// Parameter kedua HARUS pointer agar Unmarshal bisa mengisi struct
+	fmt.Println("\n--- 5. Unmarshal ---")
+
+	input := `{
+		"nama": "Ani Wijaya",
+		"umur": 22,
+		"alamat": {"jalan": "Jl. Sudirman No. 45", "kota": "Bandung", "kode_pos": "40111"},
+		"alamat_kantor": {"jalan": "Jl. Asia Afrika No. 1", "kota": "Bandung"}
+	}`
+	var ani Employee
+	if err := json.Unmarshal([]byte(input), &ani); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+	fmt.Printf("Nama: %s, Umur: %d, Kota: %s\n", ani.Nama, ani.Umur, ani.Address.Kota)
+	if ani.AlamatKantor != nil {
+		fmt.Printf("Kantor: %s (kode pos kosong: %q)\n", ani.AlamatKantor.Jalan, ani.AlamatKantor.KodePos)
+	}
+
+	// Harga bisa dibaca dari string Rupiah maupun angka
+	var p Product
+	if err := json.Unmarshal([]byte(`{"sku":"P002","nama":"Mouse","harga":"Rp250.000"}`), &p); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	} else {
+		fmt.Printf("Harga dari string: %.0f\n", float64(p.Harga))
+	}
+	if err := json.Unmarshal([]byte(`{"sku":"P002","nama":"Mouse","harga":250000}`), &p); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	} else {
+		fmt.Printf("Harga dari angka : %.0f\n", float64(p.Harga))
+	}
+
+	// =============================================================================
+	// 6. FIELD TIDAK DIKENAL
+	// =============================================================================
+	// Default: field yang tidak ada di struct DIABAIKAN tanpa error
+	fmt.Println("\n--- 6. Field Tidak Dikenal ---")
+
+	denganWarna := `{"sku":"P006","nama":"Monitor","harga":2500000,"stok":7,"warna":"hitam"}`
+
+	var longgar Product
+	err = json.Unmarshal([]byte(denganWarna), &longgar)
+	fmt.Printf("json.Unmarshal      : err=%v, nama=%s (warna diabaikan)\n", err, longgar.Nama)
+
+	_, err = DecodeProduct(strings.NewReader(denganWarna))
+	fmt.Printf("DisallowUnknownFields: %v\n", err)
+
+	// =============================================================================
+	// 7. ERROR VALIDASI
+	// =============================================================================
+	// Semua kesalahan input dipetakan ke ValidationError dari pelajaran 11
+	fmt.Println("\n--- 7. Error Validasi ---")
+
+	inputs := []string{
+		`{"sku":"P007","nama":"Webcam","harga":"Rp350.000","stok":"banyak"}`,
+		`{"sku":"P008","nama":"Speaker","harga":"Rp-abc"}`,
+		`{"sku":"P009","nama":"","harga":100000}`,
+		`{"sku":"P010","nama":"Kabel","harga":-5000}`,
+		`{"sku":"P011","nama":"Rusak",}`,
+	}
+	for _, in := range inputs {
+		_, err := DecodeProduct(strings.NewReader(in))
+		var validationErr ValidationError
+		if errors.As(err, &validationErr) {
+			fmt.Printf("ValidationError field=%-7s : %s\n", validationErr.Field, validationErr.Message)
+		} else {
+			fmt.Printf("Error lain: %v\n", err)
+		}
+	}
+
+	// =============================================================================
+	// 8. STREAMING json.Decoder DARI FILE KATALOG
+	// =============================================================================
+	fmt.Println("\n--- 8. Streaming Katalog Produk ---")
+
+	tempDir, err := os.MkdirTemp("", "pelajaran17-*")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	katalogPath := filepath.Join(tempDir, "katalog.json")
+	if err := os.WriteFile(katalogPath, []byte(katalogJSON), 0o644); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	file, err := os.Open(katalogPath)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer file.Close()
+
+	products := make(map[string]Product)
+	invalid, err := StreamKatalog(file, func(p Product) {
+		products[p.SKU] = p
+		fmt.Printf("  ✅ %s: %s - %v (stok %d)\n", p.SKU, p.Nama, p.Harga, p.Stok)
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+	for _, e := range invalid {
+		fmt.Printf("  ❌ %v\n", e)
+	}
+	fmt.Printf("%d produk valid, %d ditolak\n", len(products), len(invalid))
+
+	// =============================================================================
+	// 9. json.Encoder KE io.Writer
+	// =============================================================================
+	// Encoder menulis langsung ke os.Stdout, file, atau http.ResponseWriter
+	fmt.Println("\n--- 9. json.Encoder ---")
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(products["P003"]); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - JSON: struct tag, custom marshaler, dan streaming")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRupiahRoundTrip(t *testing.T) {
+	tests := []struct {
+		harga Rupiah
+		json  string
+	}{
+		{0, `"Rp0"`},
+		{999, `"Rp999"`},
+		{1000, `"Rp1.000"`},
+		{250000, `"Rp250.000"`},
+		{15000000, `"Rp15.000.000"`},
+		{-50000, `"-Rp50.000"`},
+		{1250.5, `"Rp1.250,50"`},
+		{0.05, `"Rp0,05"`},
+		{-1234567.89, `"-Rp1.234.567,89"`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.harga)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", float64(tt.harga), err)
+		}
+		if string(data) != tt.json {
+			t.Errorf("Marshal(%v) = %s, want %s", float64(tt.harga), data, tt.json)
+		}
+
+		var kembali Rupiah
+		if err := json.Unmarshal(data, &kembali); err != nil {
+			t.Errorf("Unmarshal(%s): %v", data, err)
+			continue
+		}
+		if kembali != tt.harga {
+			t.Errorf("Unmarshal(%s) = %v, want %v", data, float64(kembali), float64(tt.harga))
+		}
+	}
+}
+
+func TestRupiahUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Rupiah
+		wantErr bool
+	}{
+		{"angka JSON", `15000`, 15000, false},
+		{"tanpa pemisah ribuan", `"Rp15000"`, 15000, false},
+		{"sen satu digit", `"Rp1.250,5"`, 1250.5, false},
+		{"negatif", `"-Rp1.000,25"`, -1000.25, false},
+
+		{"tanpa Rp", `"15.000"`, 0, true},
+		{"kosong", `"Rp"`, 0, true},
+		{"NaN", `"RpNaN"`, 0, true},
+		{"Inf", `"RpInf"`, 0, true},
+		{"eksponen", `"Rp1e5"`, 0, true},
+		{"grup salah", `"Rp1.2.3"`, 0, true},
+		{"grup pertama terlalu panjang", `"Rp1000.000"`, 0, true},
+		{"grup terakhir kurang", `"Rp15.00"`, 0, true},
+		{"titik di akhir", `"Rp15."`, 0, true},
+		{"nol di depan", `"Rp007"`, 0, true},
+		{"sen tiga digit", `"Rp1,505"`, 0, true},
+		{"koma tanpa sen", `"Rp1,"`, 0, true},
+		{"dua koma", `"Rp1,5,0"`, 0, true},
+		{"minus setelah Rp", `"Rp-5"`, 0, true},
+		{"plus", `"Rp+5"`, 0, true},
+		{"spasi", `"Rp 5"`, 0, true},
+		{"bukan string atau angka", `true`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Rupiah
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				var verr ValidationError
+				if !errors.As(err, &verr) || verr.Field != "harga" {
+					t.Errorf("Unmarshal(%s) error = %v, want ValidationError harga", tt.data, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s): %v", tt.data, err)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.data, float64(got), float64(tt.want))
+			}
+		})
+	}
+}
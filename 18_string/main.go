@@ -0,0 +1,303 @@
+/*
+================================================================================
+PELAJARAN 18: STRING, RUNE, DAN UNICODE
+================================================================================
+
+STRING DI GO
+------------
+String di Go adalah urutan BYTE yang (biasanya) berisi teks UTF-8.
+String bersifat IMMUTABLE: tidak bisa diubah setelah dibuat.
+
+BYTE vs RUNE
+------------
+┌─────────┬──────────────────────────────────────────────────────────┐
+│ Tipe    │ Arti                                                     │
+├─────────┼──────────────────────────────────────────────────────────┤
+│ byte    │ Alias uint8, satu byte (8 bit)                           │
+│ rune    │ Alias int32, satu karakter Unicode (code point)          │
+└─────────┴──────────────────────────────────────────────────────────┘
+
+UTF-8 memakai 1 sampai 4 byte per karakter:
+┌───────────┬──────────────┬────────────────────────────────────┐
+│ Karakter  │ Jumlah byte  │ Contoh                             │
+├───────────┼──────────────┼────────────────────────────────────┤
+│ ASCII     │ 1            │ a, Z, 0, spasi                     │
+│ Latin     │ 2            │ é, ñ                               │
+│ Simbol    │ 3            │ ─ │ ┌ ≥ ✅ ❌ (box drawing, dll)    │
+│ Emoji     │ 4            │ 🎉 📋 🚀                           │
+└───────────┴──────────────┴────────────────────────────────────┘
+
+Karena itu:
+- len(s)                      → jumlah BYTE
+- utf8.RuneCountInString(s)   → jumlah KARAKTER (rune)
+- s[i]                        → BYTE ke-i (bukan karakter ke-i!)
+- for i, r := range s         → iterasi per RUNE, i adalah posisi byte
+
+PACKAGE PENTING
+---------------
+- strings  : Contains, Split, Join, Fields, Replace, ToUpper, TrimSpace, ...
+- strconv  : Atoi, Itoa, ParseFloat, ParseBool, FormatInt, Quote, ...
+- unicode  : IsLetter, IsDigit, IsSpace, IsUpper, ToUpper, ...
+- unicode/utf8 : RuneCountInString, RuneLen, ValidString, DecodeRuneInString
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// =============================================================================
+// HELPER UNTUK KOTAK (BOX DRAWING)
+// =============================================================================
+
+// simbolLebar adalah simbol di bawah blok emoji yang tetap tampil 2 kolom
+var simbolLebar = map[rune]bool{'✅': true, '❌': true, '⭐': true}
+
+// lebar menghitung lebar teks dalam jumlah kolom terminal (perkiraan).
+// Kebanyakan karakter memakai 1 kolom, tapi emoji memakai 2 kolom.
+// (Perhitungan lengkap ada di Unicode East Asian Width, ini versi sederhana.)
+func lebar(s string) int {
+	total := 0
+	for _, r := range s {
+		if r >= 0x1F300 || simbolLebar[r] { // Blok emoji seperti 🎉 🚀 📋
+			total += 2
+		} else {
+			total++
+		}
+	}
+	return total
+}
+
+// padKanan menambahkan spasi sampai teks selebar n kolom
+// fmt "%-20s" menghitung RUNE, bukan kolom, sehingga kurang tepat untuk emoji
+func padKanan(s string, n int) string {
+	if w := lebar(s); w < n {
+		return s + strings.Repeat(" ", n-w)
+	}
+	return s
+}
+
+// kotak membuat diagram kotak seperti di header setiap pelajaran
+func kotak(baris ...string) string {
+	maks := 0
+	for _, b := range baris {
+		maks = max(maks, lebar(b))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("┌" + strings.Repeat("─", maks+4) + "┐\n")
+	for _, b := range baris {
+		sb.WriteString("│  " + padKanan(b, maks) + "  │\n")
+	}
+	sb.WriteString("└" + strings.Repeat("─", maks+4) + "┘\n")
+	return sb.String()
+}
+
+// judul membuat header "=====" seperti di awal setiap pelajaran
+func judul(teks string) string {
+	garis := strings.Repeat("=", 80)
+	return garis + "\n" + teks + "\n" + garis + "\n"
+}
+
+// =============================================================================
+// PARSING DENGAN strconv
+// =============================================================================
+
+// parseUmur mengubah input teks menjadi umur, dengan pesan error yang jelas
+func parseUmur(input string) (int, error) {
+	umur, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil {
+		// strconv mengembalikan *strconv.NumError berisi Func, Num, dan Err
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) && errors.Is(numErr.Err, strconv.ErrRange) {
+			return 0, fmt.Errorf("umur %q terlalu besar", input)
+		}
+		return 0, fmt.Errorf("umur %q bukan angka: %w", input, err)
+	}
+	if umur < 0 {
+		return 0, fmt.Errorf("umur tidak boleh negatif: %d", umur)
+	}
+	return umur, nil
+}
+
+func main() {
+	fmt.Print(judul("STRING, RUNE, DAN UNICODE"))
+	fmt.Println()
+
+	// =============================================================================
+	// 1. len vs utf8.RuneCountInString
+	// =============================================================================
+	fmt.Println("--- 1. len vs RuneCountInString ---")
+
+	contoh := []string{
+		"Halo",
+		"Selamat belajar! 🎉",
+		"✅ DO",
+		"❌ DON'T",
+		"┌──┐",
+		"Sudah dewasa (≥18 tahun)",
+	}
+	for _, s := range contoh {
+		fmt.Printf("%-28q len=%-3d rune=%d\n", s, len(s), utf8.RuneCountInString(s))
+	}
+
+	// =============================================================================
+	// 2. INDEX BYTE vs RUNE
+	// =============================================================================
+	// s[i] mengambil BYTE, bukan karakter
+	fmt.Println("\n--- 2. Index Byte vs Rune ---")
+
+	emoji := "🎉Go"
+	fmt.Printf("emoji[0] = %d (byte pertama dari 🎉, bukan karakter)\n", emoji[0])
+	fmt.Printf("emoji[:4] = %s (4 byte pertama = 1 emoji)\n", emoji[:4])
+	fmt.Printf("emoji[:1] = %q (potongan UTF-8 rusak)\n", emoji[:1])
+	fmt.Printf("utf8.ValidString(emoji[:1]) = %v\n", utf8.ValidString(emoji[:1]))
+
+	// Konversi ke []rune untuk akses per karakter
+	runes := []rune(emoji)
+	fmt.Printf("[]rune: len=%d, runes[0]=%c, runes[1]=%c\n", len(runes), runes[0], runes[1])
+
+	// =============================================================================
+	// 3. RANGE OVER STRING
+	// =============================================================================
+	// for range pada string menghasilkan (posisi byte, rune)
+	fmt.Println("\n--- 3. Range per Rune ---")
+
+	for i, r := range "Rp✅🎉" {
+		fmt.Printf("  byte ke-%d: %c (U+%04X, %d byte)\n", i, r, r, utf8.RuneLen(r))
+	}
+
+	// Loop biasa dengan index menghasilkan BYTE
+	fmt.Print("  Byte dari \"✅\": ")
+	centang := "✅"
+	for i := 0; i < len(centang); i++ {
+		fmt.Printf("%X ", centang[i])
+	}
+	fmt.Println()
+
+	// =============================================================================
+	// 4. MEMBALIK STRING
+	// =============================================================================
+	fmt.Println("\n--- 4. Membalik String ---")
+
+	teks := "Halo 🎉 Dunia"
+	salah := []byte(teks)
+	for i, j := 0, len(salah)-1; i < j; i, j = i+1, j-1 {
+		salah[i], salah[j] = salah[j], salah[i]
+	}
+	benar := []rune(teks)
+	for i, j := 0, len(benar)-1; i < j; i, j = i+1, j-1 {
+		benar[i], benar[j] = benar[j], benar[i]
+	}
+	fmt.Printf("Per byte (❌): %q\n", string(salah))
+	fmt.Printf("Per rune (✅): %q\n", string(benar))
+
+	// =============================================================================
+	// 5. strings.Builder
+	// =============================================================================
+	// Menyambung string dengan + di dalam loop membuat string baru setiap kali.
+	// strings.Builder menulis ke buffer yang tumbuh, jauh lebih efisien.
+	fmt.Println("\n--- 5. strings.Builder ---")
+
+	var sb strings.Builder
+	sb.Grow(64) // Opsional: siapkan kapasitas di awal
+	for i := 1; i <= 5; i++ {
+		if i > 1 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%d²=%d", i, i*i) // Builder adalah io.Writer
+	}
+	fmt.Printf("Hasil: %s (len=%d)\n", sb.String(), sb.Len())
+
+	// =============================================================================
+	// 6. PACKAGE strings
+	// =============================================================================
+	fmt.Println("\n--- 6. Package strings ---")
+
+	kalimat := "  Go memperlakukan error sebagai value  "
+	fmt.Printf("TrimSpace   : %q\n", strings.TrimSpace(kalimat))
+	fmt.Printf("ToUpper     : %s\n", strings.ToUpper(strings.TrimSpace(kalimat)))
+	fmt.Printf("Contains    : %v\n", strings.Contains(kalimat, "error"))
+	fmt.Printf("Index       : %d\n", strings.Index(kalimat, "error"))
+	fmt.Printf("Fields      : %q\n", strings.Fields(kalimat))
+	fmt.Printf("Split       : %q\n", strings.Split("Budi,Ani,Caca", ","))
+	fmt.Printf("Join        : %s\n", strings.Join([]string{"Budi", "Ani", "Caca"}, " & "))
+	fmt.Printf("Replace     : %s\n", strings.ReplaceAll("Jl. Merdeka No. 123", "Jl.", "Jalan"))
+	fmt.Printf("HasPrefix   : %v\n", strings.HasPrefix("Rp15.000", "Rp"))
+	fmt.Printf("Repeat      : %s\n", strings.Repeat("─", 10))
+	fmt.Printf("EqualFold   : %v (tidak peka huruf besar/kecil)\n", strings.EqualFold("JAKARTA", "jakarta"))
+
+	nama, domain, _ := strings.Cut("budi@email.com", "@")
+	fmt.Printf("Cut         : nama=%s, domain=%s\n", nama, domain)
+
+	// Hitung huruf, angka, dan spasi dengan package unicode
+	huruf, angka, spasi := 0, 0, 0
+	for _, r := range "Jl. Merdeka No. 123, Jakarta" {
+		switch {
+		case unicode.IsLetter(r):
+			huruf++
+		case unicode.IsDigit(r):
+			angka++
+		case unicode.IsSpace(r):
+			spasi++
+		}
+	}
+	fmt.Printf("unicode     : %d huruf, %d angka, %d spasi\n", huruf, angka, spasi)
+
+	// =============================================================================
+	// 7. strconv: KONVERSI DAN PARSING DENGAN ERROR
+	// =============================================================================
+	fmt.Println("\n--- 7. strconv ---")
+
+	fmt.Printf("Itoa(2023)          : %q\n", strconv.Itoa(2023))
+	fmt.Printf("FormatFloat(3.14159): %s\n", strconv.FormatFloat(3.14159, 'f', 2, 64))
+	fmt.Printf("FormatInt(255, 2)   : %s\n", strconv.FormatInt(255, 2))
+	fmt.Printf("Quote(\"🎉\")         : %s\n", strconv.Quote("🎉"))
+	fmt.Printf("QuoteToASCII(\"🎉\")  : %s\n", strconv.QuoteToASCII("🎉"))
+
+	if f, err := strconv.ParseFloat("15000000.50", 64); err == nil {
+		fmt.Printf("ParseFloat          : %.2f\n", f)
+	}
+	if b, err := strconv.ParseBool("true"); err == nil {
+		fmt.Printf("ParseBool           : %v\n", b)
+	}
+
+	fmt.Println("\nParsing input umur:")
+	for _, input := range []string{"25", " 30 ", "dua puluh", "-5", "99999999999999999999", "18.5"} {
+		umur, err := parseUmur(input)
+		if err != nil {
+			fmt.Printf("  %-24q → Error: %v\n", input, err)
+			continue
+		}
+		fmt.Printf("  %-24q → %d\n", input, umur)
+	}
+
+	// =============================================================================
+	// 8. FORMAT KOTAK SEPERTI HEADER PELAJARAN
+	// =============================================================================
+	// Garis kanan kotak hanya rapi jika lebar dihitung per kolom, bukan per byte
+	fmt.Println("\n--- 8. Format Kotak (Box Drawing) ---")
+
+	fmt.Println("Padding dengan len (byte) - ❌ kanan tidak rata:")
+	for _, b := range []string{"nama := \"Budi\"", "✅ valid", "🎉 selesai"} {
+		fmt.Printf("│ %s%s │\n", b, strings.Repeat(" ", 16-len(b)))
+	}
+
+	fmt.Println("\nPadding dengan lebar kolom - ✅ rata:")
+	fmt.Print(kotak(
+		"var nama []tipe_data",
+		"nama := []tipe_data{nilai}",
+		"✅ DO  : cek error",
+		"❌ DON'T: abaikan error",
+		"🎉 Selamat belajar!",
+	))
+
+	fmt.Println()
+	fmt.Print(judul("SELESAI - String adalah byte, karakter adalah rune"))
+}
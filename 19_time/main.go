@@ -0,0 +1,311 @@
+/*
+================================================================================
+PELAJARAN 19: WAKTU DAN TANGGAL (PACKAGE time)
+================================================================================
+
+TIPE-TIPE PENTING
+-----------------
+┌────────────────┬───────────────────────────────────────────────────────┐
+│ Tipe           │ Arti                                                  │
+├────────────────┼───────────────────────────────────────────────────────┤
+│ time.Time      │ Satu titik waktu (tanggal + jam + zona waktu)         │
+│ time.Duration  │ Selisih waktu dalam nanodetik (int64)                 │
+│ time.Weekday   │ Hari dalam minggu: Sunday (0) sampai Saturday (6)     │
+│ time.Month     │ Bulan: January (1) sampai December (12)               │
+│ time.Location  │ Zona waktu, misal Asia/Jakarta                        │
+└────────────────┴───────────────────────────────────────────────────────┘
+
+LAYOUT FORMAT
+-------------
+Go TIDAK memakai YYYY-MM-DD. Go memakai TANGGAL CONTOH yang harus dihafal:
+
+    Mon Jan 2 15:04:05 MST 2006
+    (1 2 3 4 5 6 -7 → bulan 1, tanggal 2, jam 3 sore, menit 4, detik 5, 2006)
+
+┌──────────┬────────────┬───────────────────────────┐
+│ Bagian   │ Layout     │ Contoh hasil              │
+├──────────┼────────────┼───────────────────────────┤
+│ Tanggal  │ 02         │ 17                        │
+│ Bulan    │ 01         │ 08                        │
+│ Tahun    │ 2006       │ 1945                      │
+│ Jam      │ 15         │ 10 (format 24 jam)        │
+│ Menit    │ 04         │ 00                        │
+│ Detik    │ 05         │ 00                        │
+│ Zona     │ MST        │ WIB                       │
+└──────────┴────────────┴───────────────────────────┘
+Format Indonesia "tanggal-bulan-tahun" ditulis: "02-01-2006"
+
+ZONA WAKTU INDONESIA
+--------------------
+┌──────┬────────────────┬────────┬─────────────────────────────┐
+│ Zona │ Location       │ Offset │ Contoh wilayah              │
+├──────┼────────────────┼────────┼─────────────────────────────┤
+│ WIB  │ Asia/Jakarta   │ UTC+7  │ Sumatra, Jawa, Kalbar       │
+│ WITA │ Asia/Makassar  │ UTC+8  │ Bali, Sulawesi, Kalsel      │
+│ WIT  │ Asia/Jayapura  │ UTC+9  │ Maluku, Papua               │
+└──────┴────────────────┴────────┴─────────────────────────────┘
+time.LoadLocation membaca database zona waktu dari sistem operasi.
+Import _ "time/tzdata" menyertakan database tersebut ke dalam program
+(sekitar 450 KB), sehingga tetap berjalan di sistem tanpa tzdata.
+*/
+
+package main
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // Database zona waktu ikut di-embed ke binary
+)
+
+// =============================================================================
+// NAMA HARI DAN BULAN DALAM BAHASA INDONESIA
+// =============================================================================
+
+// namaHari diindeks dengan time.Weekday (Sunday = 0)
+var namaHari = [...]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+
+// namaBulan diindeks dengan time.Month (January = 1), index 0 tidak dipakai
+var namaBulan = [...]string{"", "Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+
+// Hari mengembalikan nama hari dalam bahasa Indonesia
+// Menggantikan switch hari di pelajaran 04 (yang memakai 1 = Senin)
+func Hari(d time.Weekday) string {
+	return namaHari[d]
+}
+
+// FormatIndonesia memformat waktu menjadi "Rabu, 17 Agustus 1945"
+// time.Format hanya mengenal nama bahasa Inggris, jadi nama diganti manual
+func FormatIndonesia(t time.Time) string {
+	return fmt.Sprintf("%s, %d %s %d", Hari(t.Weekday()), t.Day(), namaBulan[t.Month()], t.Year())
+}
+
+// =============================================================================
+// TAHUN KABISAT
+// =============================================================================
+
+// isKabisat adalah logika tahun kabisat dari pelajaran 04:
+// habis dibagi 4 DAN (tidak habis dibagi 100 ATAU habis dibagi 400)
+func isKabisat(tahun int) bool {
+	return tahun%4 == 0 && (tahun%100 != 0 || tahun%400 == 0)
+}
+
+// isKabisatTime memakai package time: tahun kabisat jika punya 29 Februari.
+// time.Date menormalisasi tanggal: 29 Februari di tahun biasa menjadi 1 Maret.
+func isKabisatTime(tahun int) bool {
+	return time.Date(tahun, time.February, 29, 0, 0, 0, 0, time.UTC).Month() == time.February
+}
+
+// =============================================================================
+// PARSING TANGGAL
+// =============================================================================
+
+// LayoutIndonesia adalah layout "tanggal-bulan-tahun", misal 17-08-1945
+const LayoutIndonesia = "02-01-2006"
+
+// hitungUmur menghitung umur dalam tahun pada tanggal tertentu.
+// Yang dibandingkan adalah pasangan (bulan, tanggal), bukan YearDay:
+// 1 Maret adalah hari ke-60 di tahun biasa tapi hari ke-61 di tahun kabisat,
+// sehingga YearDay bisa salah di sekitar 29 Februari.
+// Lahir 29 Februari berulang tahun pada 1 Maret di tahun biasa.
+func hitungUmur(lahir, pada time.Time) int {
+	umur := pada.Year() - lahir.Year()
+	// Kurangi 1 jika belum berulang tahun di tahun ini
+	if pada.Month() < lahir.Month() ||
+		(pada.Month() == lahir.Month() && pada.Day() < lahir.Day()) {
+		umur--
+	}
+	return umur
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("WAKTU DAN TANGGAL")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	wib, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	// =============================================================================
+	// 1. MEMBUAT time.Time
+	// =============================================================================
+	fmt.Println("--- 1. Membuat time.Time ---")
+
+	// time.Now() berbeda setiap dijalankan
+	fmt.Printf("Sekarang (lokal): %s\n", time.Now().Format("02-01-2006 15:04:05 MST"))
+
+	// time.Date untuk tanggal tertentu
+	proklamasi := time.Date(1945, time.August, 17, 10, 0, 0, 0, wib)
+	fmt.Printf("Proklamasi      : %s\n", proklamasi)
+	fmt.Printf("Tahun=%d, Bulan=%s (%d), Tanggal=%d\n",
+		proklamasi.Year(), proklamasi.Month(), proklamasi.Month(), proklamasi.Day())
+
+	// Database zona waktu menyimpan sejarah offset: tahun 1945 Jakarta
+	// memakai UTC+9 (waktu Tokyo), bukan UTC+7 seperti WIB sekarang
+	_, offset1945 := proklamasi.Zone()
+	_, offsetKini := time.Date(2025, time.January, 1, 0, 0, 0, 0, wib).Zone()
+	fmt.Printf("Offset Asia/Jakarta: 1945 = UTC%+d, 2025 = UTC%+d\n", offset1945/3600, offsetKini/3600)
+
+	// =============================================================================
+	// 2. time.Weekday MENGGANTIKAN SWITCH HARI
+	// =============================================================================
+	// Pelajaran 04: hari := 3 → "Rabu" dengan switch 1..7
+	// time.Weekday: Sunday=0, Monday=1, ..., Saturday=6
+	fmt.Println("\n--- 2. time.Weekday ---")
+
+	fmt.Printf("Weekday proklamasi: %s (%d) → %s\n",
+		proklamasi.Weekday(), proklamasi.Weekday(), Hari(proklamasi.Weekday()))
+
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		jenis := "hari kerja"
+		if d == time.Saturday || d == time.Sunday {
+			jenis = "weekend"
+		}
+		fmt.Printf("  %d %-9s = %-6s (%s)\n", d, d, Hari(d), jenis)
+	}
+
+	// =============================================================================
+	// 3. FORMAT TANGGAL
+	// =============================================================================
+	fmt.Println("\n--- 3. Format Tanggal ---")
+
+	fmt.Printf("02-01-2006          : %s\n", proklamasi.Format(LayoutIndonesia))
+	fmt.Printf("2006-01-02 (ISO)    : %s\n", proklamasi.Format(time.DateOnly))
+	fmt.Printf("15:04 MST           : %s\n", proklamasi.Format("15:04 MST"))
+	fmt.Printf("Monday, 02 Jan 2006 : %s\n", proklamasi.Format("Monday, 02 Jan 2006"))
+	fmt.Printf("RFC3339             : %s\n", proklamasi.Format(time.RFC3339))
+	fmt.Printf("Bahasa Indonesia    : %s\n", FormatIndonesia(proklamasi))
+
+	// =============================================================================
+	// 4. PARSING TANGGAL "02-01-2006"
+	// =============================================================================
+	// time.Parse memakai UTC, time.ParseInLocation memakai zona yang diberikan
+	fmt.Println("\n--- 4. Parsing Tanggal ---")
+
+	inputs := []string{"17-08-1945", "29-02-2024", "29-02-2023", "2024-01-15", "31-04-2024"}
+	for _, input := range inputs {
+		t, err := time.ParseInLocation(LayoutIndonesia, input, wib)
+		if err != nil {
+			fmt.Printf("  %-11s → Error: %v\n", input, err)
+			continue
+		}
+		fmt.Printf("  %-11s → %s\n", input, FormatIndonesia(t))
+	}
+
+	// =============================================================================
+	// 5. DURATION
+	// =============================================================================
+	fmt.Println("\n--- 5. time.Duration ---")
+
+	istirahat := 90 * time.Minute
+	fmt.Printf("90 menit        : %v (%.1f jam)\n", istirahat, istirahat.Hours())
+	fmt.Printf("Konstanta       : %v, %v, %v\n", time.Second, time.Millisecond, 24*time.Hour)
+
+	d, _ := time.ParseDuration("2h45m30s")
+	fmt.Printf("ParseDuration   : %v = %.0f detik\n", d, d.Seconds())
+	fmt.Printf("Round ke menit  : %v\n", d.Round(time.Minute))
+
+	// =============================================================================
+	// 6. ARITMATIKA TANGGAL
+	// =============================================================================
+	fmt.Println("\n--- 6. Aritmatika Tanggal ---")
+
+	awal := time.Date(2024, time.January, 31, 9, 0, 0, 0, wib)
+	fmt.Printf("Awal             : %s\n", FormatIndonesia(awal))
+	fmt.Printf("Add(48 jam)      : %s\n", FormatIndonesia(awal.Add(48*time.Hour)))
+	fmt.Printf("AddDate(0, 1, 0) : %s (31 Feb dinormalisasi!)\n", FormatIndonesia(awal.AddDate(0, 1, 0)))
+	fmt.Printf("AddDate(1, 0, 0) : %s\n", FormatIndonesia(awal.AddDate(1, 0, 0)))
+
+	sekarang := time.Date(2025, time.August, 17, 0, 0, 0, 0, wib)
+	selisih := sekarang.Sub(proklamasi)
+	fmt.Printf("Sejak proklamasi : %.0f hari\n", selisih.Hours()/24)
+	fmt.Printf("Umur RI (2025)   : %d tahun\n", hitungUmur(proklamasi, sekarang))
+
+	tenggat := time.Date(2025, time.December, 31, 23, 59, 0, 0, wib)
+	fmt.Printf("Before/After     : %v / %v\n", sekarang.Before(tenggat), sekarang.After(tenggat))
+
+	// =============================================================================
+	// 7. ZONA WAKTU WIB, WITA, WIT
+	// =============================================================================
+	// Titik waktu yang SAMA ditampilkan di zona berbeda
+	fmt.Println("\n--- 7. Zona Waktu Indonesia ---")
+
+	zona := []struct{ singkatan, lokasi string }{
+		{"WIB", "Asia/Jakarta"},
+		{"WITA", "Asia/Makassar"},
+		{"WIT", "Asia/Jayapura"},
+	}
+	rapat := time.Date(2025, time.March, 10, 2, 0, 0, 0, time.UTC)
+	fmt.Printf("Rapat online: %s\n", rapat.Format("15:04 MST"))
+	for _, z := range zona {
+		loc, err := time.LoadLocation(z.lokasi)
+		if err != nil {
+			fmt.Printf("  Error: %v\n", err)
+			continue
+		}
+		lokal := rapat.In(loc)
+		_, offset := lokal.Zone()
+		fmt.Printf("  %-4s (%-13s UTC%+d): %s\n", z.singkatan, z.lokasi, offset/3600, lokal.Format("15:04, 02-01-2006"))
+	}
+
+	// time.Equal membandingkan titik waktu, == juga membandingkan zona
+	diJayapura := rapat.In(mustLoad("Asia/Jayapura"))
+	fmt.Printf("rapat.Equal(diJayapura): %v, rapat == diJayapura: %v\n", rapat.Equal(diJayapura), rapat == diJayapura)
+
+	// =============================================================================
+	// 8. VERIFIKASI LOGIKA TAHUN KABISAT
+	// =============================================================================
+	// Bandingkan logika if-else pelajaran 04 dengan package time untuk tahun 1-9999
+	fmt.Println("\n--- 8. Verifikasi Tahun Kabisat (1-9999) ---")
+
+	jumlahKabisat, berbeda := 0, 0
+	for tahun := 1; tahun <= 9999; tahun++ {
+		manual := isKabisat(tahun)
+		if manual != isKabisatTime(tahun) {
+			berbeda++
+			fmt.Printf("  Berbeda di tahun %d!\n", tahun)
+		}
+		if manual {
+			jumlahKabisat++
+		}
+	}
+	fmt.Printf("Tahun kabisat: %d, perbedaan dengan package time: %d\n", jumlahKabisat, berbeda)
+	for _, tahun := range []int{1900, 2000, 2023, 2024} {
+		fmt.Printf("  %d: kabisat=%v, hari dalam setahun=%d\n",
+			tahun, isKabisat(tahun), time.Date(tahun, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay())
+	}
+
+	// =============================================================================
+	// 9. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 9. Best Practices Waktu ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Simpan waktu dalam UTC, ubah ke zona lokal saat ditampilkan")
+	fmt.Println("   - Gunakan t.Equal() untuk membandingkan waktu")
+	fmt.Println("   - Gunakan time.Duration, bukan int, untuk lama waktu")
+	fmt.Println("   - Import _ \"time/tzdata\" jika program berjalan di container minimal")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Menulis layout \"YYYY-MM-DD\" (Go memakai 2006-01-02)")
+	fmt.Println("   - Menganggap setiap hari = 24 jam di zona dengan daylight saving")
+	fmt.Println("   - Menghitung sendiri tahun kabisat jika package time sudah bisa")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Ingat layout ajaib: Mon Jan 2 15:04:05 MST 2006")
+	fmt.Println("================================================================================")
+}
+
+// mustLoad memuat zona waktu dan panic jika gagal
+// Aman dipakai karena tzdata sudah di-embed
+func mustLoad(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHitungUmur(t *testing.T) {
+	tanggal := func(tahun int, bulan time.Month, hari int) time.Time {
+		return time.Date(tahun, bulan, hari, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		lahir, pada time.Time
+		want        int
+	}{
+		{"tepat ulang tahun", tanggal(2000, time.May, 10), tanggal(2025, time.May, 10), 25},
+		{"sehari sebelum ulang tahun", tanggal(2000, time.May, 10), tanggal(2025, time.May, 9), 24},
+		{"bulan sebelum ulang tahun", tanggal(2000, time.May, 10), tanggal(2025, time.April, 30), 24},
+		{"hari lahir", tanggal(2025, time.May, 10), tanggal(2025, time.May, 10), 0},
+		{"proklamasi", tanggal(1945, time.August, 17), tanggal(2025, time.August, 16), 79},
+
+		// YearDay sama (60) tapi 29 Februari masih SEBELUM 1 Maret
+		{"lahir 1 Maret tahun biasa, 29 Feb tahun kabisat",
+			tanggal(2023, time.March, 1), tanggal(2024, time.February, 29), 0},
+		// YearDay 1 Maret 2024 (61) > YearDay 1 Maret 2023 (60): tetap tepat ulang tahun
+		{"lahir 1 Maret tahun biasa, 1 Maret tahun kabisat",
+			tanggal(2023, time.March, 1), tanggal(2024, time.March, 1), 1},
+		{"lahir 29 Feb, 28 Feb tahun biasa",
+			tanggal(2000, time.February, 29), tanggal(2001, time.February, 28), 0},
+		{"lahir 29 Feb, 1 Maret tahun biasa",
+			tanggal(2000, time.February, 29), tanggal(2001, time.March, 1), 1},
+		{"lahir 31 Des, 30 Des tahun kabisat",
+			tanggal(2023, time.December, 31), tanggal(2024, time.December, 30), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hitungUmur(tt.lahir, tt.pada); got != tt.want {
+				t.Errorf("hitungUmur(%s, %s) = %d, want %d",
+					tt.lahir.Format(time.DateOnly), tt.pada.Format(time.DateOnly), got, tt.want)
+			}
+		})
+	}
+}
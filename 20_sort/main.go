@@ -0,0 +1,414 @@
+/*
+================================================================================
+PELAJARAN 20: SORTING DAN SEARCHING
+================================================================================
+
+MASALAH DARI PELAJARAN 09
+-------------------------
+- Slice students dicetak sesuai urutan saat dimasukkan (insertion order)
+- Range atas map products urutannya ACAK setiap program dijalankan
+Pelajaran ini mengurutkan keduanya dan mencari data dengan binary search.
+
+CARA SORTING DI GO
+------------------
+┌──────────────────────────────┬──────────────────────────────────────────┐
+│ Fungsi                       │ Kegunaan                                 │
+├──────────────────────────────┼──────────────────────────────────────────┤
+│ slices.Sort(s)               │ Tipe cmp.Ordered (int, string, float64)  │
+│ slices.SortFunc(s, cmp)      │ Urutan bebas dengan fungsi pembanding    │
+│ slices.SortStableFunc(s,cmp) │ Seperti SortFunc, urutan data yang SAMA  │
+│                              │ tetap dipertahankan                      │
+│ sort.Sort(data)              │ Cara lama: tipe dengan Len, Less, Swap   │
+│ sort.Slice(s, less)          │ Cara lama dengan fungsi less             │
+└──────────────────────────────┴──────────────────────────────────────────┘
+
+FUNGSI PEMBANDING (cmp)
+-----------------------
+func(a, b T) int mengembalikan:
+    negatif  → a di depan b
+    0        → a dan b dianggap sama
+    positif  → a di belakang b
+cmp.Compare(x, y) menghasilkan nilai ini untuk tipe cmp.Ordered, dan
+cmp.Or(...) mengambil hasil pertama yang bukan 0 (untuk multi-key sort).
+
+BINARY SEARCH
+-------------
+Hanya bekerja pada slice yang SUDAH TERURUT. Setiap langkah membuang setengah
+data, sehingga 1 juta data hanya butuh sekitar 20 perbandingan.
+
+┌─────────────────┬───────────────┬────────────────┬──────────┐
+│ Algoritma       │ Rata-rata     │ Terburuk       │ Stabil?  │
+├─────────────────┼───────────────┼────────────────┼──────────┤
+│ Quicksort       │ O(n log n)    │ O(n²)          │ Tidak    │
+│ Mergesort       │ O(n log n)    │ O(n log n)     │ Ya       │
+│ slices.Sort     │ O(n log n)    │ O(n log n)     │ Tidak    │
+│ Binary search   │ O(log n)      │ O(log n)       │ -        │
+└─────────────────┴───────────────┴────────────────┴──────────┘
+slices.Sort memakai pdqsort (pattern-defeating quicksort) yang menghindari
+kasus terburuk O(n²).
+
+Quicksort dengan partisi dua arah (Lomuto) juga jatuh ke O(n²) jika data
+berisi banyak nilai KEMBAR: semua elemen yang sama dengan pivot masuk ke satu
+sisi, sehingga setiap langkah hanya membuang satu elemen. QuickSort di sini
+memakai partisi TIGA arah (< pivot, == pivot, > pivot); elemen kembar langsung
+selesai dan tidak ikut diurutkan ulang.
+
+BENCHMARK
+---------
+Bandingkan quicksort/mergesort buatan sendiri dengan slices.Sort
+(benchmark ada di sort_test.go):
+    go test -bench . -benchmem ./20_sort
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+Angka acak memakai seed tetap, jadi output selalu sama:
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// =============================================================================
+// STRUCT DARI PELAJARAN 09
+// =============================================================================
+
+// Person menyimpan data mahasiswa
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+}
+
+// Product menyimpan data produk
+type Product struct {
+	Nama     string
+	Harga    float64
+	Stok     int
+	Tersedia bool
+}
+
+// =============================================================================
+// sort.Interface: CARA LAMA DENGAN TIPE KUSTOM
+// =============================================================================
+
+// ByUmur mengimplementasikan sort.Interface (Len, Less, Swap)
+type ByUmur []Person
+
+func (p ByUmur) Len() int           { return len(p) }
+func (p ByUmur) Less(i, j int) bool { return p[i].Umur < p[j].Umur }
+func (p ByUmur) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// =============================================================================
+// FUNGSI PEMBANDING
+// =============================================================================
+
+// byNama membandingkan berdasarkan nama (A-Z)
+func byNama(a, b Person) int {
+	return cmp.Compare(a.Nama, b.Nama)
+}
+
+// byUmurLaluNama: umur naik, jika umur sama maka nama A-Z
+func byUmurLaluNama(a, b Person) int {
+	return cmp.Or(
+		cmp.Compare(a.Umur, b.Umur),
+		cmp.Compare(a.Nama, b.Nama),
+	)
+}
+
+// =============================================================================
+// QUICKSORT DAN MERGESORT DARI NOL
+// =============================================================================
+
+// QuickSort mengurutkan s di tempat (in-place) dengan partisi tiga arah
+// (Dutch national flag). Pivot diambil dari tengah agar data yang sudah
+// terurut tidak jatuh ke O(n²), dan elemen yang sama dengan pivot dikumpulkan
+// di tengah sehingga data dengan banyak nilai kembar juga tetap cepat.
+func QuickSort[T any](s []T, compare func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+	pivot := s[len(s)/2]
+
+	// Selama loop: s[:lt] < pivot, s[lt:i] == pivot, s[gt:] > pivot
+	lt, i, gt := 0, 0, len(s)
+	for i < gt {
+		switch c := compare(s[i], pivot); {
+		case c < 0:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case c > 0:
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+
+	// Bagian tengah (== pivot) sudah di posisi akhirnya
+	QuickSort(s[:lt], compare)
+	QuickSort(s[gt:], compare)
+}
+
+// MergeSort mengembalikan slice baru yang terurut. Algoritmanya stabil:
+// elemen yang sama tetap dalam urutan aslinya.
+func MergeSort[T any](s []T, compare func(a, b T) int) []T {
+	if len(s) < 2 {
+		return slices.Clone(s)
+	}
+	mid := len(s) / 2
+	left := MergeSort(s[:mid], compare)
+	right := MergeSort(s[mid:], compare)
+	return merge(left, right, compare)
+}
+
+// merge menggabungkan dua slice terurut menjadi satu
+func merge[T any](left, right []T, compare func(a, b T) int) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		// <= 0 (bukan < 0) agar elemen kiri menang saat sama → stabil
+		if compare(left[i], right[j]) <= 0 {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+	result = append(result, left[i:]...)
+	return append(result, right[j:]...)
+}
+
+// =============================================================================
+// HELPER
+// =============================================================================
+
+// cetakMahasiswa mencetak daftar mahasiswa dengan nomor urut
+func cetakMahasiswa(judul string, students []Person) {
+	fmt.Println(judul)
+	for i, s := range students {
+		fmt.Printf("  %d. %-16s %d tahun - %s\n", i+1, s.Nama, s.Umur, s.Alamat)
+	}
+}
+
+// angkaAcak membuat n angka acak dengan seed tetap (hasil selalu sama)
+func angkaAcak(n int) []int {
+	return angkaAcakMaks(n, 1_000_000)
+}
+
+// angkaAcakMaks membuat n angka acak di [0, maks). maks yang kecil
+// menghasilkan banyak nilai kembar.
+func angkaAcakMaks(n, maks int) []int {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.IntN(maks)
+	}
+	return s
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("SORTING DAN SEARCHING")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	students := []Person{
+		{Nama: "Eka Putri", Umur: 20, Alamat: "Surabaya"},
+		{Nama: "Fani Nugraha", Umur: 21, Alamat: "Yogyakarta"},
+		{Nama: "Gilang Ramadhan", Umur: 19, Alamat: "Semarang"},
+		{Nama: "Budi Santoso", Umur: 20, Alamat: "Jakarta"},
+		{Nama: "Ani Wijaya", Umur: 19, Alamat: "Bandung"},
+	}
+
+	// =============================================================================
+	// 1. slices.Sort UNTUK TIPE DASAR
+	// =============================================================================
+	fmt.Println("--- 1. slices.Sort untuk Tipe Dasar ---")
+
+	angka := []int{42, 7, 19, 3, 88, 7}
+	slices.Sort(angka)
+	fmt.Printf("Angka naik  : %v\n", angka)
+	slices.Reverse(angka)
+	fmt.Printf("Angka turun : %v\n", angka)
+
+	kota := []string{"Surabaya", "bandung", "Jakarta", "Aceh"}
+	slices.Sort(kota)
+	fmt.Printf("String      : %v (huruf besar di depan huruf kecil!)\n", kota)
+	slices.SortFunc(kota, func(a, b string) int {
+		return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	fmt.Printf("Tanpa case  : %v\n", kota)
+	fmt.Printf("IsSorted    : %v\n", slices.IsSorted([]int{1, 2, 3}))
+
+	// =============================================================================
+	// 2. slices.SortFunc UNTUK STRUCT
+	// =============================================================================
+	fmt.Println("\n--- 2. slices.SortFunc untuk Struct ---")
+
+	cetakMahasiswa("Urutan asli (insertion order):", students)
+
+	byName := slices.Clone(students) // Clone agar slice asli tidak berubah
+	slices.SortFunc(byName, byNama)
+	cetakMahasiswa("\nBerdasarkan nama:", byName)
+
+	// Umur turun: balik urutan argumen b dan a
+	byAgeDesc := slices.Clone(students)
+	slices.SortFunc(byAgeDesc, func(a, b Person) int {
+		return cmp.Compare(b.Umur, a.Umur)
+	})
+	cetakMahasiswa("\nBerdasarkan umur (tertua dulu):", byAgeDesc)
+
+	multi := slices.Clone(students)
+	slices.SortFunc(multi, byUmurLaluNama)
+	cetakMahasiswa("\nUmur, lalu nama (cmp.Or):", multi)
+
+	// =============================================================================
+	// 3. sort.Sort DENGAN TIPE KUSTOM
+	// =============================================================================
+	// Cara sebelum ada package slices (Go 1.21). Masih sering ditemui di kode lama.
+	fmt.Println("\n--- 3. sort.Sort dengan sort.Interface ---")
+
+	legacy := slices.Clone(students)
+	sort.Sort(ByUmur(legacy))
+	cetakMahasiswa("sort.Sort(ByUmur(...)):", legacy)
+
+	sort.Sort(sort.Reverse(ByUmur(legacy)))
+	fmt.Printf("sort.Reverse → yang pertama: %s (%d tahun)\n", legacy[0].Nama, legacy[0].Umur)
+
+	// =============================================================================
+	// 4. STABLE SORT
+	// =============================================================================
+	// Data sudah terurut berdasarkan nama. Lalu diurutkan lagi berdasarkan umur.
+	// Stable sort menjamin mahasiswa dengan umur sama TETAP urut nama.
+	fmt.Println("\n--- 4. Stable Sort ---")
+
+	stable := slices.Clone(byName)
+	slices.SortStableFunc(stable, func(a, b Person) int {
+		return cmp.Compare(a.Umur, b.Umur)
+	})
+	cetakMahasiswa("Urut nama, lalu SortStableFunc berdasarkan umur:", stable)
+	fmt.Println("→ Umur 19: Ani sebelum Gilang, umur 20: Budi sebelum Eka (urutan nama terjaga)")
+
+	// =============================================================================
+	// 5. ITERASI MAP SECARA DETERMINISTIK
+	// =============================================================================
+	// Urutan range atas map SENGAJA diacak oleh Go. Urutkan key-nya dulu.
+	fmt.Println("\n--- 5. Iterasi Map Berdasarkan Key Terurut ---")
+
+	products := map[string]Product{
+		"P003": {Nama: "Headset", Harga: 500000, Stok: 0, Tersedia: false},
+		"P001": {Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true},
+		"P002": {Nama: "Mouse Wireless", Harga: 250000, Stok: 50, Tersedia: true},
+		"P004": {Nama: "Keyboard", Harga: 750000, Stok: 25, Tersedia: true},
+	}
+
+	// slices.Sorted(maps.Keys(m)) mengumpulkan dan mengurutkan key sekaligus
+	for _, code := range slices.Sorted(maps.Keys(products)) {
+		p := products[code]
+		fmt.Printf("  %s: %-15s Rp%10.0f (stok %d)\n", code, p.Nama, p.Harga, p.Stok)
+	}
+
+	// Urutkan berdasarkan VALUE: ambil key, lalu SortFunc dengan melihat map
+	codes := slices.Collect(maps.Keys(products))
+	slices.SortFunc(codes, func(a, b string) int {
+		return cmp.Compare(products[a].Harga, products[b].Harga)
+	})
+	fmt.Printf("Kode berdasarkan harga termurah: %v\n", codes)
+
+	// =============================================================================
+	// 6. BINARY SEARCH
+	// =============================================================================
+	fmt.Println("\n--- 6. Binary Search ---")
+
+	nilai := []int{55, 60, 70, 75, 80, 90}
+	for _, cari := range []int{75, 65} {
+		i, found := slices.BinarySearch(nilai, cari)
+		fmt.Printf("BinarySearch(%v, %d) → index %d, ditemukan=%v\n", nilai, cari, i, found)
+	}
+	fmt.Println("→ Jika tidak ditemukan, index adalah posisi sisip agar tetap terurut")
+
+	// BinarySearchFunc: slice harus terurut dengan kriteria yang SAMA (nama)
+	for _, nama := range []string{"Fani Nugraha", "Dedi Kurniawan"} {
+		i, found := slices.BinarySearchFunc(byName, nama, func(p Person, target string) int {
+			return cmp.Compare(p.Nama, target)
+		})
+		if found {
+			fmt.Printf("Cari %q → index %d, %s\n", nama, i, byName[i].Alamat)
+		} else {
+			fmt.Printf("Cari %q → tidak ada, akan disisipkan di index %d\n", nama, i)
+		}
+	}
+
+	// Sisipkan data baru tanpa merusak urutan
+	baru := Person{Nama: "Dedi Kurniawan", Umur: 22, Alamat: "Medan"}
+	i, _ := slices.BinarySearchFunc(byName, baru, byNama)
+	byName = slices.Insert(byName, i, baru)
+	fmt.Printf("Setelah Insert: masih terurut? %v\n", slices.IsSortedFunc(byName, byNama))
+
+	// =============================================================================
+	// 7. QUICKSORT DAN MERGESORT BUATAN SENDIRI
+	// =============================================================================
+	fmt.Println("\n--- 7. Quicksort dan Mergesort dari Nol ---")
+
+	data := []int{38, 27, 43, 3, 9, 82, 10}
+	q := slices.Clone(data)
+	QuickSort(q, cmp.Compare[int])
+	fmt.Printf("QuickSort(%v) = %v\n", data, q)
+	fmt.Printf("MergeSort(%v) = %v\n", data, MergeSort(data, cmp.Compare[int]))
+
+	// Generic: bisa dipakai untuk struct juga
+	merged := MergeSort(byName, func(a, b Person) int { return cmp.Compare(a.Umur, b.Umur) })
+	fmt.Print("MergeSort mahasiswa (stabil) :")
+	for _, p := range merged {
+		fmt.Printf(" %s(%d)", strings.Fields(p.Nama)[0], p.Umur)
+	}
+	fmt.Println()
+
+	// Verifikasi dengan slices.Sort pada 10.000 angka acak
+	acak := angkaAcak(10_000)
+	want := slices.Clone(acak)
+	slices.Sort(want)
+	q = slices.Clone(acak)
+	QuickSort(q, cmp.Compare[int])
+	fmt.Printf("10.000 angka acak: QuickSort benar=%v, MergeSort benar=%v\n",
+		slices.Equal(q, want), slices.Equal(MergeSort(acak, cmp.Compare[int]), want))
+
+	// Banyak nilai kembar: partisi tiga arah tetap O(n log n)
+	kembar := angkaAcakMaks(10_000, 10)
+	want = slices.Clone(kembar)
+	slices.Sort(want)
+	q = slices.Clone(kembar)
+	QuickSort(q, cmp.Compare[int])
+	fmt.Printf("10.000 angka, hanya 10 nilai berbeda: QuickSort benar=%v\n", slices.Equal(q, want))
+
+	// =============================================================================
+	// 8. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 8. Best Practices Sorting ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Gunakan slices.Sort / slices.SortFunc untuk kode baru")
+	fmt.Println("   - Gunakan SortStableFunc jika urutan data yang sama penting")
+	fmt.Println("   - Urutkan key map sebelum mencetak agar output konsisten")
+	fmt.Println("   - Pakai cmp.Compare dan cmp.Or untuk fungsi pembanding")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Binary search pada slice yang belum terurut")
+	fmt.Println("   - Membandingkan dengan a - b (bisa overflow), pakai cmp.Compare")
+	fmt.Println("   - Menulis algoritma sort sendiri di production (kecuali untuk belajar)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Bandingkan performa: go test -bench . -benchmem ./20_sort")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// =============================================================================
+// BENCHMARK
+// =============================================================================
+// Jalankan dengan: go test -bench . -benchmem ./20_sort
+// Contoh hanya satu ukuran: go test -bench 'Sort/n=10000/' ./20_sort
+
+// sorters adalah semua fungsi sort yang dibandingkan
+var sorters = []struct {
+	nama string
+	sort func([]int)
+}{
+	{"QuickSort", func(s []int) { QuickSort(s, cmp.Compare[int]) }},
+	{"MergeSort", func(s []int) { MergeSort(s, cmp.Compare[int]) }},
+	{"slices.Sort", slices.Sort[[]int]},
+	{"slices.SortFunc", func(s []int) { slices.SortFunc(s, cmp.Compare[int]) }},
+	{"sort.Ints", sort.Ints},
+}
+
+// benchSort menjalankan setiap sorter pada salinan data
+func benchSort(b *testing.B, data []int) {
+	for _, s := range sorters {
+		b.Run(s.nama, func(b *testing.B) {
+			b.ReportAllocs()
+			buf := make([]int, len(data))
+			for b.Loop() {
+				copy(buf, data) // Setiap iterasi mengurutkan data yang belum terurut
+				s.sort(buf)
+			}
+		})
+	}
+}
+
+// BenchmarkSort mengurutkan angka acak dengan berbagai ukuran
+func BenchmarkSort(b *testing.B) {
+	for _, n := range []int{100, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			benchSort(b, angkaAcak(n))
+		})
+	}
+}
+
+// BenchmarkSortKembar mengurutkan 100.000 angka yang hanya punya 10 nilai
+// berbeda. Partisi tiga arah mengumpulkan nilai kembar di tengah, jadi
+// QuickSort di sini diharapkan secepat atau lebih cepat dari data acak.
+func BenchmarkSortKembar(b *testing.B) {
+	benchSort(b, angkaAcakMaks(100_000, 10))
+}
+
+// =============================================================================
+// TEST
+// =============================================================================
+
+func TestQuickSort(t *testing.T) {
+	tests := []struct {
+		name string
+		data []int
+	}{
+		{"kosong", nil},
+		{"satu elemen", []int{1}},
+		{"acak", angkaAcak(1000)},
+		{"sudah terurut", slices.Sorted(slices.Values(angkaAcak(1000)))},
+		{"semua sama", slices.Repeat([]int{7}, 1000)},
+		{"banyak kembar", angkaAcakMaks(1000, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(tt.data)
+			QuickSort(got, cmp.Compare[int])
+			if !slices.IsSorted(got) {
+				t.Errorf("QuickSort belum terurut: %v", got)
+			}
+			if merged := MergeSort(tt.data, cmp.Compare[int]); !slices.Equal(merged, got) {
+				t.Errorf("MergeSort = %v, QuickSort = %v", merged, got)
+			}
+		})
+	}
+}
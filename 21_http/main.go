@@ -0,0 +1,525 @@
+/*
+================================================================================
+PELAJARAN 21: HTTP JSON API DENGAN net/http
+================================================================================
+
+DARI FUNGSI KE SERVICE
+----------------------
+FindUser (pelajaran 11) dan map products (pelajaran 09) sekarang dibuka
+lewat HTTP. Error yang sama dipetakan ke status code HTTP:
+
+┌──────────────────────┬──────────────────┬──────────────────────────────┐
+│ Error                │ Status HTTP      │ Contoh                       │
+├──────────────────────┼──────────────────┼──────────────────────────────┤
+│ ValidationError      │ 400 Bad Request  │ GET /users/abc               │
+│ NotFoundError        │ 404 Not Found    │ GET /users/999               │
+│ panic / error lain   │ 500 Internal     │ GET /panic (hanya di test)   │
+└──────────────────────┴──────────────────┴──────────────────────────────┘
+
+PATTERN ROUTING (Go 1.22+)
+--------------------------
+http.ServeMux mendukung method dan wildcard di pattern:
+
+    mux.HandleFunc("GET /users/{id}", handler)
+    id := r.PathValue("id")
+
+┌─────────────────────────┬───────────────────────────────────────────┐
+│ Pattern                 │ Cocok dengan                              │
+├─────────────────────────┼───────────────────────────────────────────┤
+│ GET /users/{id}         │ GET /users/001 (POST → 405)               │
+│ GET /products/{sku}     │ GET /products/P001                        │
+│ GET /products           │ Hanya /products persis                    │
+│ /static/                │ Semua path berawalan /static/             │
+└─────────────────────────┴───────────────────────────────────────────┘
+
+MIDDLEWARE
+----------
+Middleware adalah fungsi yang membungkus handler:
+    func(next http.Handler) http.Handler
+
+    request → logging → recoverer → mux → handler
+                                             │
+    response ← logging ← recoverer ← ────────┘
+
+recoverer memakai ide SafeDivide (pelajaran 11): defer + recover() mengubah
+panic menjadi response 500 sehingga server tidak mati.
+
+GRACEFUL SHUTDOWN
+-----------------
+srv.Shutdown(ctx) berhenti menerima koneksi baru, lalu MENUNGGU request
+yang sedang berjalan selesai (atau sampai ctx habis).
+
+MENJALANKAN
+-----------
+    go run main.go          - Demo dengan httptest (tanpa membuka port)
+    go run main.go serve    - Server sungguhan di http://localhost:8080
+                              (tekan Ctrl+C untuk graceful shutdown)
+    curl -i localhost:8080/users/001
+    curl -i localhost:8080/products/P009
+    go test -v ./21_http    - Table-driven test handler (main_test.go)
+*/
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"regexp"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// =============================================================================
+// CUSTOM ERROR DARI PELAJARAN 11
+// =============================================================================
+
+// ValidationError menandakan input dari client tidak valid (400)
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("validasi gagal pada field '%s': %s", e.Field, e.Message)
+}
+
+// NotFoundError menandakan data tidak ditemukan (404)
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s dengan ID '%s' tidak ditemukan", e.Resource, e.ID)
+}
+
+// =============================================================================
+// DATA
+// =============================================================================
+
+// User adalah data user yang dikirim sebagai JSON
+type User struct {
+	ID   string `json:"id"`
+	Nama string `json:"nama"`
+}
+
+// Product adalah data produk yang dikirim sebagai JSON
+type Product struct {
+	SKU      string  `json:"sku"`
+	Nama     string  `json:"nama"`
+	Harga    float64 `json:"harga"`
+	Stok     int     `json:"stok"`
+	Tersedia bool    `json:"tersedia"`
+}
+
+var (
+	userIDPattern = regexp.MustCompile(`^[0-9]{3}$`)
+	skuPattern    = regexp.MustCompile(`^P[0-9]{3}$`)
+)
+
+// Store menyimpan data di memory. Hanya dibaca, jadi aman dipakai
+// bersamaan oleh banyak request (goroutine) tanpa mutex.
+type Store struct {
+	users    map[string]User
+	products map[string]Product
+}
+
+// NewStore membuat Store berisi data contoh
+func NewStore() *Store {
+	return &Store{
+		users: map[string]User{
+			"001": {ID: "001", Nama: "Budi"},
+			"002": {ID: "002", Nama: "Ani"},
+			"003": {ID: "003", Nama: "Caca"},
+		},
+		products: map[string]Product{
+			"P001": {SKU: "P001", Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true},
+			"P002": {SKU: "P002", Nama: "Mouse Wireless", Harga: 250000, Stok: 50, Tersedia: true},
+			"P003": {SKU: "P003", Nama: "Headset", Harga: 500000, Stok: 0, Tersedia: false},
+		},
+	}
+}
+
+// FindUser mencari user berdasarkan ID tiga digit, misal "001"
+func (s *Store) FindUser(id string) (User, error) {
+	if !userIDPattern.MatchString(id) {
+		return User{}, ValidationError{Field: "id", Message: "harus 3 digit angka"}
+	}
+	user, ok := s.users[id]
+	if !ok {
+		return User{}, NotFoundError{Resource: "User", ID: id}
+	}
+	return user, nil
+}
+
+// FindProduct mencari produk berdasarkan SKU, misal "P001"
+func (s *Store) FindProduct(sku string) (Product, error) {
+	if !skuPattern.MatchString(sku) {
+		return Product{}, ValidationError{Field: "sku", Message: "format harus P diikuti 3 digit"}
+	}
+	product, ok := s.products[sku]
+	if !ok {
+		return Product{}, NotFoundError{Resource: "Product", ID: sku}
+	}
+	return product, nil
+}
+
+// Products mengembalikan semua produk urut SKU (lihat pelajaran 20)
+func (s *Store) Products() []Product {
+	return slices.SortedFunc(maps.Values(s.products), func(a, b Product) int {
+		return cmp.Compare(a.SKU, b.SKU)
+	})
+}
+
+// =============================================================================
+// RESPONSE JSON
+// =============================================================================
+
+// errorResponse adalah body JSON untuk semua response error
+type errorResponse struct {
+	Status int    `json:"status"`
+	Error  string `json:"error"`
+	Field  string `json:"field,omitempty"`
+}
+
+// writeJSON menulis v sebagai JSON dengan status code tertentu
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("gagal menulis response: %v", err)
+	}
+}
+
+// writeError memetakan error ke status code dengan errors.As.
+// Error yang tidak dikenal menjadi 500 tanpa membocorkan pesan aslinya.
+func writeError(w http.ResponseWriter, err error) {
+	var validationErr ValidationError
+	var notFoundErr NotFoundError
+
+	switch {
+	case errors.As(err, &validationErr):
+		writeJSON(w, http.StatusBadRequest, errorResponse{
+			Status: http.StatusBadRequest,
+			Error:  validationErr.Message,
+			Field:  validationErr.Field,
+		})
+	case errors.As(err, &notFoundErr):
+		writeJSON(w, http.StatusNotFound, errorResponse{
+			Status: http.StatusNotFound,
+			Error:  notFoundErr.Error(),
+		})
+	default:
+		log.Printf("internal error: %v", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{
+			Status: http.StatusInternalServerError,
+			Error:  http.StatusText(http.StatusInternalServerError),
+		})
+	}
+}
+
+// =============================================================================
+// HANDLER
+// =============================================================================
+
+func handleUser(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, err := store.FindUser(r.PathValue("id"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, user)
+	}
+}
+
+func handleProduct(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		product, err := store.FindProduct(r.PathValue("sku"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, product)
+	}
+}
+
+func handleProducts(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, store.Products())
+	}
+}
+
+// =============================================================================
+// MIDDLEWARE
+// =============================================================================
+
+// statusRecorder mencatat status code yang ditulis handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logging mencatat method, path, status, dan durasi setiap request
+func logging(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			logger.Printf("%s %s → %d (%v)", r.Method, r.URL.Path, rec.status, time.Since(start).Round(time.Microsecond))
+		})
+	}
+}
+
+// recoverer mengubah panic di handler menjadi response 500,
+// sama seperti SafeDivide mengubah panic menjadi error
+func recoverer(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if v := recover(); v != nil {
+					// http.ErrAbortHandler dipakai untuk membatalkan response, jangan ditelan
+					if v == http.ErrAbortHandler {
+						panic(v)
+					}
+					logger.Printf("panic recovered: %v", v)
+					writeJSON(w, http.StatusInternalServerError, errorResponse{
+						Status: http.StatusInternalServerError,
+						Error:  http.StatusText(http.StatusInternalServerError),
+					})
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// newMux mendaftarkan semua route API. Test bisa menambah route khusus
+// (misal handler yang sengaja panic) tanpa ikut terpasang di server sungguhan.
+func newMux(store *Store) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", handleUser(store))
+	mux.HandleFunc("GET /products/{sku}", handleProduct(store))
+	mux.HandleFunc("GET /products", handleProducts(store))
+	return mux
+}
+
+// withMiddleware membungkus handler dengan recoverer lalu logging.
+// Middleware terluar dipasang terakhir.
+func withMiddleware(handler http.Handler, logger *log.Logger) http.Handler {
+	handler = recoverer(logger)(handler)
+	handler = logging(logger)(handler)
+	return handler
+}
+
+// NewServer menyusun router dan middleware menjadi satu http.Handler.
+// Fungsi ini yang diuji dengan httptest, tanpa perlu membuka port.
+func NewServer(store *Store, logger *log.Logger) http.Handler {
+	return withMiddleware(newMux(store), logger)
+}
+
+func main() {
+	// Mode server sungguhan: go run main.go serve
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		if err := serve(":8080"); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Println("================================================================================")
+	fmt.Println("HTTP JSON API")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	logger := log.New(os.Stdout, "    [log] ", 0)
+	handler := NewServer(NewStore(), logger)
+
+	// =============================================================================
+	// 1. httptest.NewRecorder: PANGGIL HANDLER LANGSUNG
+	// =============================================================================
+	// Tidak ada jaringan: ServeHTTP menulis ke ResponseRecorder di memory
+	fmt.Println("--- 1. httptest.NewRecorder ---")
+
+	req := httptest.NewRequest(http.MethodGet, "/users/001", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	fmt.Printf("Status       : %d\n", rec.Code)
+	fmt.Printf("Content-Type : %s\n", rec.Header().Get("Content-Type"))
+	fmt.Printf("Body         : %s", rec.Body.String())
+
+	// =============================================================================
+	// 2. ERROR MENJADI STATUS CODE
+	// =============================================================================
+	// Setiap jenis error dipetakan writeError ke status yang berbeda.
+	// Semua kasus (termasuk panic → 500) diuji di main_test.go:
+	//   go test -v ./21_http
+	fmt.Println("\n--- 2. Error Menjadi Status Code ---")
+
+	for _, r := range []struct{ method, path string }{
+		{"GET", "/users/abc"},
+		{"GET", "/users/999"},
+		{"POST", "/users/001"},
+		{"GET", "/orders"},
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(r.method, r.path, nil))
+		fmt.Printf("  %-4s %-11s → %d %s\n", r.method, r.path, rec.Code, http.StatusText(rec.Code))
+	}
+	fmt.Println("Table-driven test lengkap: go test -v ./21_http")
+
+	// =============================================================================
+	// 3. httptest.NewServer: SERVER SUNGGUHAN DI PORT ACAK
+	// =============================================================================
+	// Berguna untuk menguji client HTTP, termasuk header dan decoding JSON
+	fmt.Println("\n--- 3. httptest.NewServer + http.Client ---")
+
+	ts := httptest.NewServer(NewServer(NewStore(), log.New(io.Discard, "", 0)))
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/products")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	var products []Product
+	err = json.NewDecoder(resp.Body).Decode(&products)
+	resp.Body.Close()
+	if err != nil {
+		fmt.Printf("Error decode: %v\n", err)
+		return
+	}
+	fmt.Printf("GET /products → %s, %d produk\n", resp.Status, len(products))
+	for _, p := range products {
+		fmt.Printf("  %s %-15s stok %d\n", p.SKU, p.Nama, p.Stok)
+	}
+
+	// =============================================================================
+	// 4. GRACEFUL SHUTDOWN
+	// =============================================================================
+	// Shutdown menunggu request yang sedang berjalan sampai selesai
+	fmt.Println("\n--- 4. Graceful Shutdown ---")
+	gracefulShutdownDemo()
+
+	// =============================================================================
+	// 5. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 5. Best Practices HTTP ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Petakan tipe error ke status code di SATU tempat (writeError)")
+	fmt.Println("   - Pasang middleware recoverer agar panic tidak mematikan server")
+	fmt.Println("   - Set ReadHeaderTimeout di http.Server")
+	fmt.Println("   - Uji handler dengan httptest tanpa membuka port")
+	fmt.Println("   - Gunakan srv.Shutdown(ctx) saat menerima SIGINT/SIGTERM")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Mengirim pesan error internal (query SQL, stack trace) ke client")
+	fmt.Println("   - Memakai http.ListenAndServe tanpa timeout di production")
+	fmt.Println("   - Lupa menutup resp.Body di sisi client")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Coba server sungguhan: go run main.go serve")
+	fmt.Println("================================================================================")
+}
+
+// gracefulShutdownDemo menjalankan server dengan handler lambat, lalu
+// memanggil Shutdown saat request masih diproses
+func gracefulShutdownDemo() {
+	started := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /lambat", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond) // Simulasi pekerjaan berat
+		fmt.Fprintln(w, "selesai")
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0") // Port 0 = pilih port kosong
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go srv.Serve(ln)
+
+	url := "http://" + ln.Addr().String()
+	hasil := make(chan string)
+	go func() {
+		resp, err := http.Get(url + "/lambat")
+		if err != nil {
+			hasil <- "error: " + err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		hasil <- fmt.Sprintf("%s, body=%q", resp.Status, strings.TrimSpace(string(body)))
+	}()
+
+	<-started
+	fmt.Println("Request /lambat sedang diproses, memanggil Shutdown...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		fmt.Printf("Shutdown error: %v\n", err)
+	}
+	fmt.Println("Shutdown selesai setelah request terakhir dijawab")
+	fmt.Printf("Request yang sedang berjalan: %s\n", <-hasil)
+
+	_, err = http.Get(url + "/lambat")
+	fmt.Printf("Request baru setelah shutdown ditolak: %v\n", err != nil)
+}
+
+// serve menjalankan server sungguhan sampai menerima Ctrl+C (SIGINT)
+// atau SIGTERM, lalu melakukan graceful shutdown
+func serve(addr string) error {
+	logger := log.New(os.Stdout, "", log.LstdFlags)
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           NewServer(NewStore(), logger),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Printf("server berjalan di http://localhost%s", addr)
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err // Gagal start, misal port sudah dipakai
+	case <-ctx.Done():
+	}
+
+	logger.Println("sinyal diterima, shutdown...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	logger.Println("server berhenti")
+	return nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// handlePanic sengaja panic untuk menguji middleware recoverer.
+// Hanya didaftarkan di mux milik test, tidak pernah di server sungguhan.
+func handlePanic(w http.ResponseWriter, r *http.Request) {
+	var products map[string]Product
+	products["P999"] = Product{} // panic: assignment to entry in nil map
+}
+
+// newTestServer sama dengan NewServer ditambah route GET /panic
+func newTestServer(logger *log.Logger) http.Handler {
+	mux := newMux(NewStore())
+	mux.HandleFunc("GET /panic", handlePanic)
+	return withMiddleware(mux, logger)
+}
+
+func TestServer(t *testing.T) {
+	handler := newTestServer(log.New(io.Discard, "", 0))
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string // Potongan yang harus ada di body
+	}{
+		{"user ada", "GET", "/users/002", 200, `"nama":"Ani"`},
+		{"user tidak ada", "GET", "/users/999", 404, `User dengan ID '999' tidak ditemukan`},
+		{"id tidak valid", "GET", "/users/abc", 400, `"field":"id"`},
+		{"produk ada", "GET", "/products/P001", 200, `"harga":15000000`},
+		{"produk tidak ada", "GET", "/products/P009", 404, `Product dengan ID 'P009'`},
+		{"sku tidak valid", "GET", "/products/xyz", 400, `"field":"sku"`},
+		{"daftar produk", "GET", "/products", 200, `"sku":"P003"`},
+		{"method salah", "POST", "/users/001", 405, ``},
+		{"route tidak ada", "GET", "/orders", 404, ``},
+		{"panic di handler", "GET", "/panic", 500, `Internal Server Error`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("%s %s status = %d, want %d", tt.method, tt.path, rec.Code, tt.wantStatus)
+			}
+			if body := rec.Body.String(); !strings.Contains(body, tt.wantBody) {
+				t.Errorf("%s %s body = %q, want berisi %q", tt.method, tt.path, body, tt.wantBody)
+			}
+		})
+	}
+}
+
+// Route /panic tidak boleh ada di server sungguhan
+func TestNewServerTanpaPanicRoute(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewServer(NewStore(), log.New(io.Discard, "", 0)).
+		ServeHTTP(rec, httptest.NewRequest("GET", "/panic", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GET /panic status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+// recoverer juga mencatat panic ke logger
+func TestRecovererLogsPanic(t *testing.T) {
+	var buf strings.Builder
+	handler := newTestServer(log.New(&buf, "", 0))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/panic", nil))
+
+	if !strings.Contains(buf.String(), "panic recovered: assignment to entry in nil map") {
+		t.Errorf("log = %q, want berisi pesan panic", buf.String())
+	}
+	if !strings.Contains(buf.String(), "GET /panic → 500") {
+		t.Errorf("log = %q, want berisi status 500", buf.String())
+	}
+}
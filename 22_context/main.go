@@ -0,0 +1,375 @@
+/*
+================================================================================
+PELAJARAN 22: CONTEXT (PEMBATALAN DAN DEADLINE)
+================================================================================
+
+MASALAH DI PELAJARAN 11
+-----------------------
+GetUserFromDB hanya mengembalikan string "connection timeout". Tidak ada cara
+bagi pemanggil untuk berkata "saya hanya mau menunggu 100ms" atau "user sudah
+menutup halaman, hentikan pekerjaan ini".
+
+context.Context menyelesaikan masalah itu. Context dibawa sebagai PARAMETER
+PERTAMA ke setiap fungsi yang bisa lama (database, HTTP, file besar):
+
+    func GetUserFromDB(ctx context.Context, id string) (string, error)
+
+MEMBUAT CONTEXT
+---------------
+┌───────────────────────────────────┬──────────────────────────────────────┐
+│ Fungsi                            │ Kegunaan                             │
+├───────────────────────────────────┼──────────────────────────────────────┤
+│ context.Background()              │ Akar context (di main, test)         │
+│ context.WithCancel(parent)        │ Bisa dibatalkan dengan cancel()      │
+│ context.WithCancelCause(parent)   │ cancel(err) sekaligus memberi alasan │
+│ context.WithTimeout(parent, d)    │ Batal otomatis setelah durasi d      │
+│ context.WithDeadline(parent, t)   │ Batal otomatis pada waktu t          │
+│ context.WithValue(parent, k, v)   │ Membawa data request (request ID)    │
+└───────────────────────────────────┴──────────────────────────────────────┘
+
+MEMBACA STATUS CONTEXT
+----------------------
+┌─────────────────────┬──────────────────────────────────────────────────┐
+│ ctx.Done()          │ Channel yang ditutup saat context batal          │
+│ ctx.Err()           │ nil, context.Canceled, atau DeadlineExceeded     │
+│ context.Cause(ctx)  │ Alasan pembatalan dari WithCancelCause           │
+│ ctx.Value(key)      │ Nilai yang disimpan dengan WithValue             │
+└─────────────────────┴──────────────────────────────────────────────────┘
+
+POHON CONTEXT
+-------------
+    Background
+        └── WithTimeout(1s)          ← batal → semua anak ikut batal
+                ├── WithValue(requestID)
+                └── WithCancel       ← batal → induk TIDAK terpengaruh
+
+ATURAN
+------
+- Selalu panggil cancel() (biasanya dengan defer) agar resource dilepas
+- Jangan simpan context di dalam struct, kirim lewat parameter
+- WithValue hanya untuk data yang melekat pada request, bukan parameter fungsi
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// DATABASE ERROR DARI PELAJARAN 11
+// =============================================================================
+
+// DatabaseError membungkus error dari operasi database
+type DatabaseError struct {
+	Op  string // Operasi yang gagal
+	Err error  // Error asli, di sini bisa context.DeadlineExceeded
+}
+
+func (e DatabaseError) Error() string {
+	return fmt.Sprintf("database error saat %s: %v", e.Op, e.Err)
+}
+
+// Unwrap membuat errors.Is(err, context.DeadlineExceeded) tetap bekerja
+func (e DatabaseError) Unwrap() error {
+	return e.Err
+}
+
+// =============================================================================
+// NILAI DI DALAM CONTEXT
+// =============================================================================
+
+// ctxKey adalah tipe unexported untuk key context. Package lain tidak bisa
+// membuat key dengan tipe ini, sehingga nilai kita tidak bisa tertimpa.
+type ctxKey int
+
+const requestIDKey ctxKey = iota
+
+// WithRequestID mengembalikan context baru yang membawa request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestIDFrom membaca request ID. ok bernilai false jika tidak ada.
+// Type assertion dengan koma-ok agar tidak panic jika nilainya tidak ada.
+func RequestIDFrom(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(requestIDKey).(string)
+	return id, ok
+}
+
+// logf mencetak pesan dengan request ID jika ada
+func logf(ctx context.Context, format string, args ...any) {
+	id, ok := RequestIDFrom(ctx)
+	if !ok {
+		id = "-"
+	}
+	fmt.Printf("  [req=%s] %s\n", id, fmt.Sprintf(format, args...))
+}
+
+// =============================================================================
+// GetUserFromDB DENGAN CONTEXT
+// =============================================================================
+
+// userDB mensimulasikan tabel user beserta lama query untuk setiap ID
+var userDB = map[string]struct {
+	nama  string
+	delay time.Duration
+}{
+	"001": {"Budi", 10 * time.Millisecond},
+	"002": {"Ani", 300 * time.Millisecond}, // Query lambat
+}
+
+// errCause menggabungkan ctx.Err() dengan alasan pembatalan (jika berbeda),
+// sehingga errors.Is bisa menemukan keduanya
+func errCause(ctx context.Context) error {
+	err, cause := ctx.Err(), context.Cause(ctx)
+	if cause == nil || cause == err {
+		return err
+	}
+	return fmt.Errorf("%w: %w", err, cause)
+}
+
+// GetUserFromDB mencari user dan berhenti segera jika ctx dibatalkan
+// atau deadline-nya lewat, tanpa menunggu query selesai
+func GetUserFromDB(ctx context.Context, id string) (string, error) {
+	row, ok := userDB[id]
+	if !ok {
+		return "", fmt.Errorf("user %s tidak ditemukan", id)
+	}
+
+	// Cek di awal: tidak perlu memulai query jika context sudah batal
+	if err := ctx.Err(); err != nil {
+		return "", DatabaseError{Op: "get user by id", Err: errCause(ctx)}
+	}
+
+	timer := time.NewTimer(row.delay) // Simulasi query database
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		logf(ctx, "query user %s selesai dalam %v", id, row.delay)
+		return row.nama, nil
+	case <-ctx.Done():
+		logf(ctx, "query user %s dibatalkan: %v", id, context.Cause(ctx))
+		return "", DatabaseError{Op: "get user by id", Err: errCause(ctx)}
+	}
+}
+
+// =============================================================================
+// ProcessFile DENGAN CONTEXT
+// =============================================================================
+
+// ProcessFile menghitung baris file. Context dicek SETIAP baris sehingga
+// pemrosesan file besar bisa dihentikan di tengah jalan.
+// Jumlah baris yang sudah diproses tetap dikembalikan bersama error.
+func ProcessFile(ctx context.Context, filename string) (lines int, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, fmt.Errorf("process file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return lines, fmt.Errorf("process file %s berhenti di baris %d: %w",
+				filepath.Base(filename), lines+1, errCause(ctx))
+		}
+		time.Sleep(time.Millisecond) // Simulasi pekerjaan per baris
+		lines++
+	}
+	if err := scanner.Err(); err != nil {
+		return lines, fmt.Errorf("process file: %w", err)
+	}
+	return lines, nil
+}
+
+// ErrUserCancelled adalah alasan pembatalan yang dikirim lewat WithCancelCause
+var ErrUserCancelled = errors.New("dibatalkan oleh user")
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("CONTEXT")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. context.Background DAN QUERY NORMAL
+	// =============================================================================
+	fmt.Println("--- 1. Query Tanpa Batas Waktu ---")
+
+	ctx := context.Background()
+	nama, err := GetUserFromDB(ctx, "001")
+	fmt.Printf("GetUserFromDB(001) = %q, err = %v\n", nama, err)
+
+	// =============================================================================
+	// 2. context.WithTimeout
+	// =============================================================================
+	// User 002 butuh 300ms, tapi kita hanya mau menunggu 100ms
+	fmt.Println("\n--- 2. context.WithTimeout ---")
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel() // Selalu panggil cancel, meski timeout sudah lewat
+
+	start := time.Now()
+	_, err = GetUserFromDB(timeoutCtx, "002")
+	fmt.Printf("Kembali setelah ~%v (bukan 300ms)\n", time.Since(start).Round(50*time.Millisecond))
+	fmt.Printf("Error: %v\n", err)
+
+	deadline, _ := timeoutCtx.Deadline()
+	fmt.Printf("Deadline sudah lewat: %v\n", time.Now().After(deadline))
+
+	// =============================================================================
+	// 3. DeadlineExceeded DI DALAM DatabaseError
+	// =============================================================================
+	// Error sudah dibungkus DatabaseError, tapi errors.Is masih menemukannya
+	fmt.Println("\n--- 3. errors.Is dan errors.As Menembus DatabaseError ---")
+
+	fmt.Printf("errors.Is(err, context.DeadlineExceeded) = %v\n", errors.Is(err, context.DeadlineExceeded))
+	fmt.Printf("errors.Is(err, context.Canceled)         = %v\n", errors.Is(err, context.Canceled))
+	fmt.Printf("err == context.DeadlineExceeded          = %v (jangan pakai ==)\n", err == context.DeadlineExceeded)
+
+	var dbErr DatabaseError
+	if errors.As(err, &dbErr) {
+		fmt.Printf("errors.As → DatabaseError{Op: %q}\n", dbErr.Op)
+	}
+
+	// Pola umum di handler: timeout → 504, error lain → 500
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		fmt.Println("Handler membalas: 504 Gateway Timeout")
+	case err != nil:
+		fmt.Println("Handler membalas: 500 Internal Server Error")
+	}
+
+	// =============================================================================
+	// 4. WithCancelCause DAN context.Cause
+	// =============================================================================
+	// ctx.Err() hanya bilang "canceled". Cause menjelaskan KENAPA.
+	fmt.Println("\n--- 4. WithCancelCause dan context.Cause ---")
+
+	causeCtx, cancelCause := context.WithCancelCause(ctx)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancelCause(ErrUserCancelled) // Misal: user menekan tombol Batal
+	}()
+
+	_, err = GetUserFromDB(causeCtx, "002")
+	fmt.Printf("Error         : %v\n", err)
+	fmt.Printf("ctx.Err()     : %v\n", causeCtx.Err())
+	fmt.Printf("context.Cause : %v\n", context.Cause(causeCtx))
+	fmt.Printf("errors.Is(err, context.Canceled) = %v, errors.Is(err, ErrUserCancelled) = %v\n",
+		errors.Is(err, context.Canceled), errors.Is(err, ErrUserCancelled))
+
+	// WithTimeoutCause: alasan khusus saat deadline lewat
+	slowCtx, cancel2 := context.WithTimeoutCause(ctx, 20*time.Millisecond,
+		errors.New("SLA query user 20ms terlampaui"))
+	defer cancel2()
+	_, err = GetUserFromDB(slowCtx, "002")
+	fmt.Printf("WithTimeoutCause: %v\n", err)
+
+	// =============================================================================
+	// 5. ProcessFile YANG BISA DIBATALKAN
+	// =============================================================================
+	fmt.Println("\n--- 5. ProcessFile dengan Context ---")
+
+	dir, err := os.MkdirTemp("", "pelajaran22-*")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data.txt")
+	isi := strings.Repeat("baris data\n", 500)
+	if err := os.WriteFile(path, []byte(isi), 0o644); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	lines, err := ProcessFile(ctx, path)
+	fmt.Printf("Tanpa batas waktu : %d baris, err = %v\n", lines, err)
+
+	fileCtx, cancel3 := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel3()
+	lines, err = ProcessFile(fileCtx, path)
+	fmt.Printf("Timeout 50ms      : %d dari 500 baris (sebagian)\n", lines)
+	fmt.Printf("Error             : %v\n", err)
+	fmt.Printf("DeadlineExceeded? : %v\n", errors.Is(err, context.DeadlineExceeded))
+
+	// Context yang sudah batal: ProcessFile berhenti sebelum baris pertama
+	doneCtx, cancel4 := context.WithCancelCause(ctx)
+	cancel4(ErrUserCancelled)
+	lines, err = ProcessFile(doneCtx, path)
+	fmt.Printf("Sudah dibatalkan  : %d baris, err = %v\n", lines, err)
+
+	// =============================================================================
+	// 6. NILAI DI CONTEXT (WithValue)
+	// =============================================================================
+	fmt.Println("\n--- 6. context.WithValue dengan Key Bertipe Unexported ---")
+
+	reqCtx := WithRequestID(ctx, "REQ-7f3a")
+	if id, ok := RequestIDFrom(reqCtx); ok {
+		fmt.Printf("Request ID: %s\n", id)
+	}
+	nama, _ = GetUserFromDB(reqCtx, "001") // Log di dalam fungsi ikut mencetak request ID
+	fmt.Printf("User: %s\n", nama)
+
+	// Key int biasa (0) TIDAK sama dengan ctxKey(0) walau angkanya sama
+	fmt.Printf("ctx.Value(0) = %v, ctx.Value(requestIDKey) = %v\n", reqCtx.Value(0), reqCtx.Value(requestIDKey))
+
+	_, ok := RequestIDFrom(ctx)
+	fmt.Printf("Context tanpa request ID → ok = %v\n", ok)
+
+	// =============================================================================
+	// 7. PROPAGASI PEMBATALAN DI POHON CONTEXT
+	// =============================================================================
+	fmt.Println("\n--- 7. Pembatalan Mengalir ke Anak, Tidak ke Induk ---")
+
+	parent, cancelParent := context.WithCancel(ctx)
+	child := WithRequestID(parent, "REQ-anak") // Anak mewarisi pembatalan induk
+	grandChild, cancelGrandChild := context.WithCancel(child)
+
+	cancelGrandChild()
+	fmt.Printf("Cucu dibatalkan  → induk: %v, anak: %v, cucu: %v\n", parent.Err(), child.Err(), grandChild.Err())
+
+	cancelParent()
+	fmt.Printf("Induk dibatalkan → induk: %v, anak: %v\n", parent.Err(), child.Err())
+	id, _ := RequestIDFrom(child)
+	fmt.Printf("Nilai tetap bisa dibaca setelah batal: %s\n", id)
+
+	// WithoutCancel: pekerjaan lanjutan (misal menulis audit log) yang
+	// tidak boleh ikut batal, tapi tetap membawa nilai request
+	detached := context.WithoutCancel(child)
+	id, _ = RequestIDFrom(detached)
+	fmt.Printf("WithoutCancel → err: %v, request ID: %s\n", detached.Err(), id)
+
+	// =============================================================================
+	// 8. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 8. Best Practices Context ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Jadikan ctx parameter pertama: func F(ctx context.Context, ...)")
+	fmt.Println("   - defer cancel() tepat setelah WithTimeout/WithCancel")
+	fmt.Println("   - Cek error dengan errors.Is(err, context.DeadlineExceeded)")
+	fmt.Println("   - Gunakan WithCancelCause agar alasan pembatalan tidak hilang")
+	fmt.Println("   - Pakai tipe key unexported untuk WithValue")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Mengirim nil sebagai context (pakai context.TODO())")
+	fmt.Println("   - Menyimpan context di field struct")
+	fmt.Println("   - Memakai WithValue untuk parameter opsional fungsi")
+	fmt.Println("   - Membandingkan err == context.Canceled pada error yang di-wrap")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Context: satu parameter untuk deadline, pembatalan, dan nilai request")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,467 @@
+/*
+================================================================================
+PELAJARAN 23: FILE DAN DIREKTORI I/O
+================================================================================
+
+Pelajaran 11 membahas "close file" dan "cleanup resource". Sekarang kita
+benar-benar menyentuh filesystem. SEMUA contoh berjalan di direktori sementara
+(os.MkdirTemp) yang dihapus di akhir program.
+
+MEMBACA DAN MENULIS
+-------------------
+┌───────────────────────────┬─────────────────────────────────────────────┐
+│ Fungsi                    │ Kegunaan                                    │
+├───────────────────────────┼─────────────────────────────────────────────┤
+│ os.ReadFile / WriteFile   │ Seluruh isi file sekaligus (file kecil)     │
+│ os.Open / os.Create       │ *os.File untuk dibaca / ditulis bertahap    │
+│ os.OpenFile(flag, perm)   │ Kontrol penuh: O_APPEND, O_EXCL, ...        │
+│ bufio.Scanner             │ Membaca per baris / per kata                │
+│ bufio.Writer              │ Menulis dengan buffer (jangan lupa Flush)   │
+│ filepath.WalkDir          │ Menelusuri seluruh pohon direktori          │
+└───────────────────────────┴─────────────────────────────────────────────┘
+
+io.Reader DAN io.Writer
+-----------------------
+Dua interface kecil yang menyatukan file, network, buffer, dan hash:
+
+    type Reader interface { Read(p []byte) (n int, err error) }
+    type Writer interface { Write(p []byte) (n int, err error) }
+
+Karena kecil, keduanya mudah DIRANGKAI:
+
+    file ──► io.TeeReader ──► io.Copy ──► io.MultiWriter ──┬──► file tujuan
+                  │                                        └──► hitung byte
+                  └──► sha256 (hash sambil membaca)
+
+PERMISSION FILE (OKTAL)
+-----------------------
+┌────────┬──────────────────────────────────────────────┐
+│ 0o644  │ pemilik baca/tulis, lainnya hanya baca       │
+│ 0o600  │ hanya pemilik yang bisa baca/tulis           │
+│ 0o755  │ direktori / file executable                  │
+└────────┴──────────────────────────────────────────────┘
+
+fs.FS DAN embed
+---------------
+fs.FS adalah filesystem read-only yang abstrak. Fungsi yang menerima fs.FS
+bisa dipakai dengan:
+- os.DirFS(dir)       → direktori sungguhan
+- embed.FS            → file yang ditanam ke dalam binary (//go:embed)
+- fstest.MapFS        → filesystem di memory, cocok untuk test
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing/fstest"
+)
+
+// sampleData berisi file di folder data/ yang ikut di-compile ke binary.
+// Program tetap bisa membacanya walau dijalankan dari direktori lain.
+//
+//go:embed data
+var sampleData embed.FS
+
+// =============================================================================
+// ATOMIC WRITE
+// =============================================================================
+
+// WriteFileAtomic menulis data ke file sementara di direktori yang SAMA,
+// lalu me-rename ke path tujuan. Rename di satu filesystem bersifat atomic:
+// pembaca hanya melihat file lama atau file baru yang lengkap, tidak pernah
+// file yang setengah tertulis (misal jika program crash di tengah jalan).
+func WriteFileAtomic(path string, data []byte, perm fs.FileMode) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	// Jika ada langkah yang gagal, hapus file sementara
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	if err = tmp.Sync(); err != nil { // Pastikan data benar-benar sampai ke disk
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("atomic write: %w", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// FUNGSI YANG MENERIMA fs.FS
+// =============================================================================
+
+// HitungBaris menghitung jumlah baris setiap file yang cocok dengan pattern.
+// Karena menerima fs.FS, fungsi ini tidak peduli file berasal dari disk,
+// embed, atau memory.
+func HitungBaris(fsys fs.FS, pattern string) (map[string]int, error) {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int, len(names))
+	for _, name := range names {
+		f, err := fsys.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		lines := 0
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			lines++
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = lines
+	}
+	return result, nil
+}
+
+// cetakHitungan mencetak hasil HitungBaris urut nama file
+func cetakHitungan(sumber string, fsys fs.FS, pattern string) {
+	counts, err := HitungBaris(fsys, pattern)
+	if err != nil {
+		fmt.Printf("  %s: error: %v\n", sumber, err)
+		return
+	}
+	fmt.Printf("  %s:\n", sumber)
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fmt.Printf("    %-22s %d baris\n", name, counts[name])
+	}
+}
+
+// byteCounter adalah io.Writer yang hanya menghitung byte yang ditulis
+type byteCounter int64
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	*c += byteCounter(len(p))
+	return len(p), nil
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("FILE DAN DIREKTORI I/O")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// Semua contoh memakai direktori sementara yang dihapus di akhir
+	dir, err := os.MkdirTemp("", "pelajaran23-*")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// rel menampilkan path relatif terhadap dir agar output tidak bergantung
+	// pada nama direktori sementara
+	rel := func(path string) string {
+		r, err := filepath.Rel(dir, path)
+		if err != nil {
+			return path
+		}
+		return filepath.ToSlash(r)
+	}
+
+	// =============================================================================
+	// 1. os.WriteFile DAN os.ReadFile
+	// =============================================================================
+	fmt.Println("--- 1. os.WriteFile dan os.ReadFile ---")
+
+	catatan := filepath.Join(dir, "catatan.txt")
+	if err := os.WriteFile(catatan, []byte("Belajar Go\nHari ke-23\n"), 0o644); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	data, err := os.ReadFile(catatan)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("Isi %s (%d byte): %q\n", rel(catatan), len(data), data)
+
+	info, _ := os.Stat(catatan)
+	fmt.Printf("Stat: nama=%s, ukuran=%d, mode=%v, dir=%v\n", info.Name(), info.Size(), info.Mode(), info.IsDir())
+
+	// Menambah di akhir file dengan O_APPEND
+	f, err := os.OpenFile(catatan, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Fprintln(f, "Topik: File I/O")
+	if err := f.Close(); err != nil { // Error dari Close pada file tulis WAJIB dicek
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	// File yang tidak ada → fs.ErrNotExist
+	_, err = os.ReadFile(filepath.Join(dir, "tidak-ada.txt"))
+	fmt.Printf("File tidak ada: errors.Is(err, fs.ErrNotExist) = %v\n", errors.Is(err, fs.ErrNotExist))
+
+	// O_EXCL: gagal jika file sudah ada (mencegah menimpa tanpa sengaja)
+	_, err = os.OpenFile(catatan, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	fmt.Printf("O_EXCL pada file yang ada: errors.Is(err, fs.ErrExist) = %v\n", errors.Is(err, fs.ErrExist))
+
+	// =============================================================================
+	// 2. bufio.Scanner DAN bufio.Writer
+	// =============================================================================
+	fmt.Println("\n--- 2. bufio.Scanner dan bufio.Writer ---")
+
+	f, err = os.Open(catatan)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		fmt.Printf("  baris %d: %s\n", n, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+	f.Close()
+
+	// bufio.Writer mengumpulkan tulisan kecil menjadi satu write besar
+	laporan := filepath.Join(dir, "laporan.txt")
+	out, err := os.Create(laporan)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	w := bufio.NewWriter(out)
+	for i := 1; i <= 3; i++ {
+		fmt.Fprintf(w, "Item %d\n", i)
+	}
+	fmt.Printf("Sebelum Flush: %d byte di buffer, file berisi %d byte\n", w.Buffered(), fileSize(laporan))
+	if err := w.Flush(); err != nil { // Tanpa Flush, data di buffer HILANG
+		fmt.Printf("Error: %v\n", err)
+	}
+	out.Close()
+	fmt.Printf("Setelah Flush: file berisi %d byte\n", fileSize(laporan))
+
+	// =============================================================================
+	// 3. MERANGKAI io.Reader DAN io.Writer
+	// =============================================================================
+	fmt.Println("\n--- 3. io.TeeReader dan io.MultiWriter ---")
+
+	src, err := os.Open(catatan)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer src.Close()
+
+	salinan := filepath.Join(dir, "salinan.txt")
+	dst, err := os.Create(salinan)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	// Satu kali baca: data di-hash (TeeReader), lalu ditulis ke file
+	// dan ke penghitung sekaligus (MultiWriter)
+	hash := sha256.New()
+	var counter byteCounter
+	n, err := io.Copy(io.MultiWriter(dst, &counter), io.TeeReader(src, hash))
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("io.Copy: %d byte, counter: %d byte\n", n, counter)
+	fmt.Printf("SHA-256: %x...\n", hash.Sum(nil)[:8])
+
+	asli, _ := os.ReadFile(catatan)
+	hasil, _ := os.ReadFile(salinan)
+	fmt.Printf("Salinan identik: %v\n", string(asli) == string(hasil))
+
+	// Reader lain: strings.Reader, io.LimitReader, io.MultiReader
+	r := io.MultiReader(strings.NewReader("Halo, "), io.LimitReader(strings.NewReader("Gopher Indonesia!"), 6))
+	b, _ := io.ReadAll(r)
+	fmt.Printf("MultiReader + LimitReader: %q\n", b)
+
+	// =============================================================================
+	// 4. ATOMIC WRITE VIA RENAME
+	// =============================================================================
+	fmt.Println("\n--- 4. Atomic Write via Rename ---")
+
+	config := filepath.Join(dir, "config.json")
+	if err := WriteFileAtomic(config, []byte(`{"versi": 1}`), 0o600); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if err := WriteFileAtomic(config, []byte(`{"versi": 2}`), 0o600); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	data, _ = os.ReadFile(config)
+	info, _ = os.Stat(config)
+	fmt.Printf("%s = %s, mode=%v\n", rel(config), data, info.Mode())
+
+	sisa, _ := filepath.Glob(filepath.Join(dir, ".config.json.tmp-*"))
+	fmt.Printf("File sementara tersisa: %d\n", len(sisa))
+
+	err = WriteFileAtomic(filepath.Join(dir, "tidak-ada", "x.json"), nil, 0o600)
+	fmt.Printf("Direktori tidak ada → %v\n", errors.Is(err, fs.ErrNotExist))
+
+	// =============================================================================
+	// 5. filepath.WalkDir
+	// =============================================================================
+	fmt.Println("\n--- 5. filepath.WalkDir ---")
+
+	proyek := filepath.Join(dir, "proyek")
+	for _, p := range []string{"cmd/app/main.go", "internal/db/db.go", "internal/db/db_test.go", "tmp/cache.bin", "README.md"} {
+		full := filepath.Join(proyek, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if err := os.WriteFile(full, []byte("// "+p+"\n"), 0o644); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	var goFiles int
+	err = filepath.WalkDir(proyek, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err // Misal: tidak punya izin membaca direktori
+		}
+		depth := strings.Count(rel(path), "/")
+		if d.IsDir() && d.Name() == "tmp" {
+			fmt.Printf("  %s%s/ [dilewati]\n", strings.Repeat("  ", depth), d.Name())
+			return fs.SkipDir // Jangan masuk ke direktori ini
+		}
+		name := d.Name()
+		if d.IsDir() {
+			name += "/"
+		} else if filepath.Ext(name) == ".go" {
+			goFiles++
+		}
+		fmt.Printf("  %s%s\n", strings.Repeat("  ", depth), name)
+		return nil
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+	fmt.Printf("Jumlah file .go: %d\n", goFiles)
+
+	// =============================================================================
+	// 6. fs.FS: SATU FUNGSI, BANYAK SUMBER
+	// =============================================================================
+	fmt.Println("\n--- 6. fs.FS (os.DirFS, embed.FS, fstest.MapFS) ---")
+
+	cetakHitungan("os.DirFS(proyek)", os.DirFS(proyek), "internal/db/*.go")
+	cetakHitungan("embed.FS", sampleData, "data/*")
+	memFS := fstest.MapFS{
+		"a.txt":     {Data: []byte("satu\ndua\n")},
+		"sub/b.txt": {Data: []byte("tiga\n")},
+	}
+	cetakHitungan("fstest.MapFS", memFS, "*.txt")
+
+	// fstest.TestFS memeriksa implementasi fs.FS beserta daftar file yang diharapkan
+	fmt.Printf("fstest.TestFS(memFS)      : %v\n", fstest.TestFS(memFS, "a.txt", "sub/b.txt"))
+	fmt.Printf("fstest.TestFS(sampleData) : %v\n", fstest.TestFS(sampleData, "data/produk.csv"))
+
+	// Path di fs.FS selalu memakai "/" dan tidak boleh diawali "/" atau ".."
+	fmt.Printf("fs.ValidPath: %q=%v, %q=%v, %q=%v\n",
+		"data/produk.csv", fs.ValidPath("data/produk.csv"),
+		"/etc/passwd", fs.ValidPath("/etc/passwd"),
+		"../rahasia", fs.ValidPath("../rahasia"))
+
+	// =============================================================================
+	// 7. EMBED: DATA CONTOH DI DALAM BINARY
+	// =============================================================================
+	fmt.Println("\n--- 7. embed untuk Data Contoh ---")
+
+	entries, _ := sampleData.ReadDir("data")
+	for _, e := range entries {
+		info, _ := e.Info()
+		fmt.Printf("  data/%-14s %3d byte\n", e.Name(), info.Size())
+	}
+
+	produk, _ := sampleData.ReadFile("data/produk.csv")
+	header, _, _ := strings.Cut(string(produk), "\n")
+	fmt.Printf("Header produk.csv: %s\n", header)
+
+	// Salin seluruh isi embed ke disk dengan os.CopyFS (Go 1.23+)
+	sampel := filepath.Join(dir, "sampel")
+	if err := os.CopyFS(sampel, sampleData); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	disalin, _ := filepath.Glob(filepath.Join(sampel, "data", "*"))
+	for i, p := range disalin {
+		disalin[i] = rel(p)
+	}
+	fmt.Printf("os.CopyFS → %v\n", disalin)
+
+	// =============================================================================
+	// 8. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 8. Best Practices File I/O ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - defer f.Close() tepat setelah Open berhasil")
+	fmt.Println("   - Cek error Close() untuk file yang DITULIS")
+	fmt.Println("   - Panggil Flush() pada bufio.Writer")
+	fmt.Println("   - Gunakan filepath.Join, bukan menyambung string dengan \"/\"")
+	fmt.Println("   - Terima fs.FS atau io.Reader di fungsi agar mudah diuji")
+	fmt.Println("   - Tulis file penting dengan temp file + rename")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - os.ReadFile untuk file berukuran GB (pakai bufio / io.Copy)")
+	fmt.Println("   - Permission 0o777 untuk file biasa")
+	fmt.Println("   - Menulis file sementara di direktori kerja (pakai os.MkdirTemp)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Semua file sementara sudah dihapus")
+	fmt.Println("================================================================================")
+}
+
+// fileSize mengembalikan ukuran file, atau -1 jika gagal
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return -1
+	}
+	return info.Size()
+}
@@ -0,0 +1,466 @@
+/*
+================================================================================
+PELAJARAN 24: PROGRAM COMMAND-LINE (CLI) DENGAN PACKAGE flag
+================================================================================
+
+Fungsi hitungLuasKeliling, factorial, dan sum dari pelajaran 08 selama ini
+dipanggil dengan argumen yang ditulis langsung di kode. Pelajaran ini
+mengubahnya menjadi CLI kecil dengan beberapa subcommand:
+
+    go run main.go luas -panjang 5 -lebar 3
+    go run main.go luas -panjang 5 -lebar 3 -format json
+    go run main.go faktorial 10
+    go run main.go jumlah 1 2 3 4
+    go run main.go jumlah -5 3
+    echo "10 20 30" | go run main.go jumlah -
+    go run main.go help
+
+Tanpa argumen, program menjalankan demo. Test CLI ada di main_test.go:
+    go test -v ./24_cli
+
+PACKAGE flag
+------------
+┌──────────────────────────────────┬──────────────────────────────────────┐
+│ Kode                             │ Arti                                 │
+├──────────────────────────────────┼──────────────────────────────────────┤
+│ fs := flag.NewFlagSet(nama, h)   │ Kumpulan flag untuk satu subcommand  │
+│ p := fs.Int("panjang", 0, "...") │ Flag -panjang, hasilnya *int         │
+│ fs.StringVar(&s, "format", ...)  │ Flag disimpan langsung ke variabel   │
+│ fs.Parse(args)                   │ Parse flag, berhenti di argumen non- │
+│                                  │ flag pertama                         │
+│ fs.Args() / fs.NArg()            │ Argumen posisi sisanya               │
+└──────────────────────────────────┴──────────────────────────────────────┘
+Penulisan yang diterima: -lebar 3, -lebar=3, --lebar 3
+
+ANGKA NEGATIF
+-------------
+Bagi package flag, "-5" terlihat seperti flag bernama 5. Perintah jumlah dan
+faktorial (yang flag-nya hanya bool) berhenti membaca flag di angka pertama,
+jadi "jumlah -5 3" bekerja. Cara umum yang selalu bisa dipakai adalah "--":
+semua argumen setelahnya adalah argumen posisi, misal "jumlah -v -- -5 3".
+
+flag.ContinueOnError membuat Parse MENGEMBALIKAN error (bukan langsung
+os.Exit), sehingga fungsi bisa diuji.
+
+EXIT CODE
+---------
+┌──────┬──────────────────────────────────────────────────────┐
+│ 0    │ Sukses (termasuk -h / help)                          │
+│ 1    │ Error saat menjalankan (misal angka terlalu besar)   │
+│ 2    │ Cara pakai salah (flag tidak dikenal, argumen kurang)│
+└──────┴──────────────────────────────────────────────────────┘
+Di shell, cek dengan: echo $?
+
+POLA run() YANG BISA DIUJI
+--------------------------
+main() hanya menjadi pembungkus tipis:
+
+    func main() {
+        os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+    }
+
+Semua logika ada di run(), yang menerima argumen dan stream sebagai
+parameter. Test cukup memberi strings.Reader dan bytes.Buffer, tanpa
+menjalankan proses baru dan tanpa menyentuh os.Stdout.
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Exit code program
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// namaProgram dipakai di pesan bantuan
+const namaProgram = "kalkulator"
+
+// errUsage menandakan kesalahan cara pakai (exit code 2).
+// Pesan detailnya sudah dicetak ke stderr sebelum error ini dikembalikan.
+var errUsage = errors.New("cara pakai salah")
+
+// =============================================================================
+// FUNGSI DARI PELAJARAN 08
+// =============================================================================
+
+func hitungLuasKeliling(panjang, lebar int) (luas int, keliling int) {
+	luas = panjang * lebar
+	keliling = 2 * (panjang + lebar)
+	return
+}
+
+func sum(angka ...int) int {
+	total := 0
+	for _, n := range angka {
+		total += n
+	}
+	return total
+}
+
+func factorial(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * factorial(n-1)
+}
+
+// maxFaktorial adalah n terbesar yang hasil factorial-nya muat di int64
+const maxFaktorial = 20
+
+// =============================================================================
+// SUBCOMMAND
+// =============================================================================
+
+// command menggambarkan satu subcommand
+type command struct {
+	nama      string
+	ringkasan string
+	run       func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+// commands diisi di init() karena cmdHelp juga membaca commands
+var commands []command
+
+func init() {
+	commands = []command{
+		{"luas", "Hitung luas dan keliling persegi panjang", cmdLuas},
+		{"faktorial", "Hitung faktorial n (0-20)", cmdFaktorial},
+		{"jumlah", "Jumlahkan angka dari argumen atau stdin", cmdJumlah},
+		{"help", "Tampilkan bantuan", cmdHelp},
+	}
+}
+
+// newFlagSet membuat FlagSet dengan pesan bantuan berbahasa Indonesia
+func newFlagSet(nama, pakai, keterangan string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(nama, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Cara pakai: %s %s %s\n\n%s\n", namaProgram, nama, pakai, keterangan)
+		if hasFlags(fs) {
+			fmt.Fprintln(stderr, "\nFlag:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// hasFlags mengecek apakah FlagSet punya minimal satu flag
+func hasFlags(fs *flag.FlagSet) bool {
+	ada := false
+	fs.VisitAll(func(*flag.Flag) { ada = true })
+	return ada
+}
+
+// parseFlags memanggil fs.Parse dan menerjemahkan error-nya:
+// -h/-help → flag.ErrHelp (exit 0), flag salah → errUsage (exit 2)
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage // Pesan error dan Usage sudah dicetak oleh flag
+	}
+	return nil
+}
+
+// parseFlagsAngka seperti parseFlags, tetapi angka negatif (misal -5)
+// dianggap argumen posisi, bukan flag. Parsing flag berhenti di argumen
+// pertama yang berupa angka, "-", "--", atau tidak diawali "-", sama seperti
+// fs.Parse berhenti di argumen non-flag pertama. "--" sendiri dibuang.
+// Hanya untuk perintah yang semua flag-nya bool: pada "-n -5" nilai -5 akan
+// dianggap argumen posisi.
+func parseFlagsAngka(fs *flag.FlagSet, args []string) ([]string, error) {
+	i := 0
+	for ; i < len(args); i++ {
+		a := args[i]
+		if _, err := strconv.Atoi(a); err == nil || !strings.HasPrefix(a, "-") || a == "-" || a == "--" {
+			break
+		}
+	}
+	if err := parseFlags(fs, args[:i]); err != nil {
+		return nil, err
+	}
+	rest := args[i:]
+	if len(rest) > 0 && rest[0] == "--" {
+		rest = rest[1:]
+	}
+	return rest, nil
+}
+
+// usageError mencetak pesan dan cara pakai, lalu mengembalikan errUsage
+func usageError(fs *flag.FlagSet, format string, args ...any) error {
+	fmt.Fprintf(fs.Output(), "error: "+format+"\n", args...)
+	fs.Usage()
+	return errUsage
+}
+
+func cmdLuas(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("luas", "-panjang N -lebar N [-format teks|json]",
+		"Menghitung luas dan keliling persegi panjang.", stderr)
+	panjang := fs.Int("panjang", 0, "panjang persegi (wajib, > 0)")
+	lebar := fs.Int("lebar", 0, "lebar persegi (wajib, > 0)")
+	format := fs.String("format", "teks", "format output: teks atau json")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError(fs, "argumen tidak dikenal: %v", fs.Args())
+	}
+	if *panjang <= 0 || *lebar <= 0 {
+		return usageError(fs, "-panjang dan -lebar harus lebih dari 0")
+	}
+
+	luas, keliling := hitungLuasKeliling(*panjang, *lebar)
+	switch *format {
+	case "teks":
+		fmt.Fprintf(stdout, "Luas: %d\nKeliling: %d\n", luas, keliling)
+	case "json":
+		return json.NewEncoder(stdout).Encode(map[string]int{"luas": luas, "keliling": keliling})
+	default:
+		return usageError(fs, "format %q tidak dikenal (pilih teks atau json)", *format)
+	}
+	return nil
+}
+
+func cmdFaktorial(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("faktorial", "N", "Menghitung N! untuk 0 <= N <= 20.", stderr)
+	posisi, err := parseFlagsAngka(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(posisi) != 1 {
+		return usageError(fs, "butuh tepat satu angka, didapat %d", len(posisi))
+	}
+
+	n, err := strconv.Atoi(posisi[0])
+	if err != nil {
+		return usageError(fs, "%q bukan bilangan bulat", posisi[0])
+	}
+	// Input yang formatnya benar tapi tidak bisa dihitung → exit 1, bukan 2
+	if n < 0 || n > maxFaktorial {
+		return fmt.Errorf("faktorial %d di luar jangkauan 0-%d (hasil tidak muat di int64)", n, maxFaktorial)
+	}
+	fmt.Fprintf(stdout, "%d! = %d\n", n, factorial(n))
+	return nil
+}
+
+func cmdJumlah(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("jumlah", "[-v] [--] [ANGKA...] | -",
+		"Menjumlahkan angka (boleh negatif). Tanpa argumen atau dengan \"-\", angka dibaca dari stdin.", stderr)
+	verbose := fs.Bool("v", false, "tampilkan setiap angka")
+	inputs, err := parseFlagsAngka(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(inputs) == 0 || (len(inputs) == 1 && inputs[0] == "-") {
+		var err error
+		if inputs, err = readWords(stdin); err != nil {
+			return fmt.Errorf("membaca stdin: %w", err)
+		}
+	}
+
+	angka := make([]int, 0, len(inputs))
+	for _, s := range inputs {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("%q bukan bilangan bulat", s)
+		}
+		angka = append(angka, n)
+	}
+
+	if *verbose {
+		fmt.Fprintf(stdout, "Angka: %v\n", angka)
+	}
+	fmt.Fprintf(stdout, "Jumlah: %d\n", sum(angka...))
+	return nil
+}
+
+// readWords membaca seluruh stdin dan memecahnya per kata (spasi/baris baru)
+func readWords(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		words = append(words, scanner.Text())
+	}
+	return words, scanner.Err()
+}
+
+func cmdHelp(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	printUsage(stdout)
+	return nil
+}
+
+// printUsage mencetak daftar subcommand
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Cara pakai: %s <perintah> [flag] [argumen]\n\nPerintah:\n", namaProgram)
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.nama, c.ringkasan)
+	}
+	fmt.Fprintf(w, "\nGunakan \"%s <perintah> -h\" untuk bantuan tiap perintah.\n", namaProgram)
+}
+
+// =============================================================================
+// run: TITIK MASUK YANG BISA DIUJI
+// =============================================================================
+
+// run menjalankan CLI dan mengembalikan exit code.
+// Tidak memanggil os.Exit dan tidak menyentuh os.Stdin/Stdout/Stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	name := args[0]
+	if name == "-h" || name == "-help" || name == "--help" {
+		name = "help"
+	}
+
+	for _, c := range commands {
+		if c.nama != name {
+			continue
+		}
+		err := c.run(args[1:], stdin, stdout, stderr)
+		switch {
+		case err == nil, errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.Is(err, errUsage):
+			return exitUsage
+		default:
+			fmt.Fprintf(stderr, "%s %s: %v\n", namaProgram, name, err)
+			return exitError
+		}
+	}
+
+	fmt.Fprintf(stderr, "%s: perintah %q tidak dikenal\n\n", namaProgram, name)
+	printUsage(stderr)
+	return exitUsage
+}
+
+func main() {
+	// Dengan argumen: jalankan sebagai CLI sungguhan
+	if len(os.Args) > 1 {
+		os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
+	fmt.Println("================================================================================")
+	fmt.Println("PROGRAM COMMAND-LINE (CLI)")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. os.Args
+	// =============================================================================
+	// os.Args[0] adalah path program, argumen dimulai dari os.Args[1]
+	fmt.Println("--- 1. os.Args ---")
+	fmt.Printf("len(os.Args) = %d (tanpa argumen, hanya nama program)\n", len(os.Args))
+	fmt.Println("Coba: go run main.go faktorial 5")
+
+	// =============================================================================
+	// 2. MEMANGGIL run() SEPERTI DARI TERMINAL
+	// =============================================================================
+	fmt.Println("\n--- 2. Memanggil run() Langsung ---")
+
+	demos := []struct {
+		args  []string
+		stdin string
+	}{
+		{[]string{"luas", "-panjang", "5", "-lebar", "3"}, ""},
+		{[]string{"luas", "-panjang=5", "-lebar=3", "-format", "json"}, ""},
+		{[]string{"faktorial", "10"}, ""},
+		{[]string{"jumlah", "-v", "1", "2", "3", "4"}, ""},
+		{[]string{"jumlah", "-5", "3"}, ""},
+		{[]string{"jumlah"}, "10 20\n30\n"},
+		{[]string{"faktorial", "25"}, ""},
+		{[]string{"luas", "-panjang", "5"}, ""},
+		{[]string{"hitung"}, ""},
+	}
+
+	for _, d := range demos {
+		var stdout, stderr bytes.Buffer
+		code := run(d.args, strings.NewReader(d.stdin), &stdout, &stderr)
+
+		perintah := "$ " + namaProgram + " " + strings.Join(d.args, " ")
+		if d.stdin != "" {
+			perintah = fmt.Sprintf("$ printf %q | %s %s", d.stdin, namaProgram, strings.Join(d.args, " "))
+		}
+		fmt.Println(perintah)
+		printIndented("stdout", stdout.String())
+		printIndented("stderr", stderr.String())
+		fmt.Printf("  exit code: %d\n\n", code)
+	}
+
+	// =============================================================================
+	// 3. HELP DALAM BAHASA INDONESIA
+	// =============================================================================
+	fmt.Println("--- 3. Pesan Bantuan (-h) ---")
+
+	var help bytes.Buffer
+	code := run([]string{"luas", "-h"}, strings.NewReader(""), io.Discard, &help)
+	fmt.Print(help.String())
+	fmt.Printf("exit code: %d (meminta bantuan bukan error)\n", code)
+
+	// =============================================================================
+	// 4. MENGUJI CLI DENGAN POLA run()
+	// =============================================================================
+	// Karena run() tidak menyentuh os.Stdout dan tidak memanggil os.Exit,
+	// test cukup memanggilnya dengan bytes.Buffer (lihat main_test.go)
+	fmt.Println("\n--- 4. Menguji run() ---")
+
+	fmt.Println("Table-driven test di main_test.go memeriksa exit code, stdout, dan stderr:")
+	fmt.Println("  var stdout, stderr bytes.Buffer")
+	fmt.Println("  code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)")
+	fmt.Println("Jalankan: go test -v ./24_cli")
+
+	// =============================================================================
+	// 5. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 5. Best Practices CLI ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Letakkan logika di run(args, stdin, stdout, stderr) int")
+	fmt.Println("   - Hasil ke stdout, pesan error dan bantuan ke stderr")
+	fmt.Println("   - Exit code 0 sukses, 1 error, 2 cara pakai salah")
+	fmt.Println("   - Terima \"-\" sebagai tanda baca dari stdin")
+	fmt.Println("   - Gunakan flag.NewFlagSet untuk setiap subcommand")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Memanggil os.Exit di luar main() (defer tidak dijalankan)")
+	fmt.Println("   - Memakai flag global (flag.Int) di kode yang ingin diuji")
+	fmt.Println("   - Mencetak error ke stdout (merusak output untuk pipe)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Coba: go run main.go help")
+	fmt.Println("================================================================================")
+}
+
+// printIndented mencetak output stream dengan label dan indentasi
+func printIndented(label, s string) {
+	if s == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		fmt.Println(strings.TrimRight("  "+label+" │ "+line, " "))
+	}
+}
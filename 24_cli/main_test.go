@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantCode   int
+		wantStdout string // Potongan yang harus ada di stdout
+		wantStderr string // Potongan yang harus ada di stderr
+	}{
+		{"luas teks", []string{"luas", "-panjang", "5", "-lebar", "3"}, "", exitOK, "Luas: 15\nKeliling: 16", ""},
+		{"luas json", []string{"luas", "-panjang", "2", "-lebar", "2", "-format", "json"}, "", exitOK, `{"keliling":8,"luas":4}`, ""},
+		{"luas format salah", []string{"luas", "-panjang", "2", "-lebar", "2", "-format", "xml"}, "", exitUsage, "", `format "xml" tidak dikenal`},
+		{"luas negatif", []string{"luas", "-panjang", "-5", "-lebar", "3"}, "", exitUsage, "", "harus lebih dari 0"},
+		{"flag tidak dikenal", []string{"luas", "-tinggi", "3"}, "", exitUsage, "", "flag provided but not defined: -tinggi"},
+		{"luas -h", []string{"luas", "-h"}, "", exitOK, "", "Cara pakai: kalkulator luas"},
+		{"faktorial 5", []string{"faktorial", "5"}, "", exitOK, "5! = 120", ""},
+		{"faktorial 20", []string{"faktorial", "20"}, "", exitOK, "20! = 2432902008176640000", ""},
+		{"faktorial overflow", []string{"faktorial", "21"}, "", exitError, "", "di luar jangkauan"},
+		{"faktorial negatif", []string{"faktorial", "-3"}, "", exitError, "", "faktorial -3 di luar jangkauan"},
+		{"faktorial bukan angka", []string{"faktorial", "lima"}, "", exitUsage, "", `"lima" bukan bilangan bulat`},
+		{"faktorial tanpa angka", []string{"faktorial"}, "", exitUsage, "", "butuh tepat satu angka, didapat 0"},
+		{"jumlah argumen", []string{"jumlah", "1", "2", "3"}, "", exitOK, "Jumlah: 6", ""},
+		{"jumlah negatif di depan", []string{"jumlah", "-5", "3"}, "", exitOK, "Jumlah: -2", ""},
+		{"jumlah -v lalu negatif", []string{"jumlah", "-v", "-5", "-3"}, "", exitOK, "Angka: [-5 -3]\nJumlah: -8", ""},
+		{"jumlah dengan --", []string{"jumlah", "-v", "--", "-5", "3"}, "", exitOK, "Jumlah: -2", ""},
+		{"jumlah flag tidak dikenal", []string{"jumlah", "-x", "1"}, "", exitUsage, "", "flag provided but not defined: -x"},
+		{"jumlah stdin", []string{"jumlah", "-"}, "4\n5 6", exitOK, "Jumlah: 15", ""},
+		{"jumlah stdin negatif", []string{"jumlah", "-"}, "-4 10", exitOK, "Jumlah: 6", ""},
+		{"jumlah stdin kosong", []string{"jumlah"}, "", exitOK, "Jumlah: 0", ""},
+		{"jumlah input salah", []string{"jumlah", "1", "x"}, "", exitError, "", `"x" bukan bilangan bulat`},
+		{"tanpa perintah", nil, "", exitUsage, "", "Cara pakai: kalkulator <perintah>"},
+		{"perintah tidak dikenal", []string{"hitung"}, "", exitUsage, "", `perintah "hitung" tidak dikenal`},
+		{"help", []string{"help"}, "", exitOK, "faktorial", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+
+			if code != tt.wantCode {
+				t.Errorf("exit code = %d, want %d (stderr: %q)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.wantStdout) {
+				t.Errorf("stdout = %q, want berisi %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("stderr = %q, want berisi %q", stderr.String(), tt.wantStderr)
+			}
+			// Sukses tidak boleh menulis ke stderr; error tidak boleh ke stdout
+			if tt.wantCode == exitOK && tt.wantStderr == "" && stderr.Len() > 0 {
+				t.Errorf("stderr tidak kosong saat sukses: %q", stderr.String())
+			}
+			if tt.wantCode != exitOK && stdout.Len() > 0 {
+				t.Errorf("stdout tidak kosong saat error: %q", stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,476 @@
+/*
+================================================================================
+PELAJARAN 25: ITERATOR (RANGE OVER FUNC, Go 1.23+)
+================================================================================
+
+Pelajaran 05 mengajarkan range untuk slice, string, map, dan channel.
+Sejak Go 1.23, range juga bisa dipakai pada FUNGSI dengan bentuk tertentu.
+Fungsi seperti ini disebut iterator.
+
+BENTUK ITERATOR (package iter)
+------------------------------
+┌───────────────────┬──────────────────────────────────┬───────────────────────┐
+│ Tipe              │ Definisi                         │ Dipakai dengan        │
+├───────────────────┼──────────────────────────────────┼───────────────────────┤
+│ iter.Seq[V]       │ func(yield func(V) bool)         │ for v := range seq    │
+│ iter.Seq2[K, V]   │ func(yield func(K, V) bool)      │ for k, v := range seq │
+└───────────────────┴──────────────────────────────────┴───────────────────────┘
+
+CARA KERJA
+----------
+    for n := range Counter(1) {     func Counter(start int) iter.Seq[int] {
+        if n > 3 {                      return func(yield func(int) bool) {
+            break  ─────────────┐           for n := start; ; n++ {
+        }                       │               if !yield(n) { ◄── false
+        fmt.Println(n)          │                   return
+    }                           └─────────────► }
+                                                }
+                                            }
+                                        }
+- Body loop menjadi fungsi yield
+- yield mengembalikan false jika loop berhenti (break, return, panic)
+- Iterator WAJIB berhenti memanggil yield setelah menerima false
+
+PUSH VS PULL
+------------
+- Push (iter.Seq): iterator yang memanggil body loop. Cocok untuk for-range.
+- Pull (iter.Pull): kita yang meminta nilai berikutnya dengan next().
+  Cocok untuk menggabungkan dua iterator sekaligus. Wajib memanggil stop().
+
+FUNGSI STANDAR YANG MENGEMBALIKAN / MENERIMA ITERATOR
+-----------------------------------------------------
+┌─────────────────────────┬────────────────────────────────────────────────┐
+│ slices.All / Values     │ Iterator dari slice (index+value / value)      │
+│ slices.Backward         │ Iterasi dari belakang                          │
+│ slices.Collect(seq)     │ Kumpulkan iterator menjadi slice               │
+│ slices.Sorted(seq)      │ Kumpulkan lalu urutkan                         │
+│ maps.Keys / Values      │ Iterator key / value dari map                  │
+│ maps.All / Collect      │ Iterator pasangan key-value / kumpulkan ke map │
+│ strings.SplitSeq        │ Split tanpa membuat slice (Go 1.24+)           │
+└─────────────────────────┴────────────────────────────────────────────────┘
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// =============================================================================
+// ITERATOR SEDERHANA
+// =============================================================================
+
+// counter adalah closure dari pelajaran 08: setiap panggilan menambah 1
+func counter() func() int {
+	n := 0
+	return func() int {
+		n++
+		return n
+	}
+}
+
+// Counter adalah versi iterator dari counter(): bisa langsung di-range.
+// Iterator ini tidak pernah habis, jadi pemanggil yang harus break.
+func Counter(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := start; ; n++ {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Fibonacci menghasilkan 0, 1, 1, 2, 3, 5, ... tanpa batas
+func Fibonacci() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 0, 1
+		for {
+			if !yield(a) {
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+// Take mengambil paling banyak n nilai pertama dari seq
+func Take[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i == n {
+				return
+			}
+		}
+	}
+}
+
+// Filter hanya meneruskan nilai yang membuat keep bernilai true
+func Filter[V any](seq iter.Seq[V], keep func(V) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// =============================================================================
+// ITERATOR UNTUK MAP: KEY TERURUT
+// =============================================================================
+
+// SortedMap mengiterasi map berdasarkan key terurut, sehingga outputnya
+// selalu sama (range biasa atas map urutannya acak)
+func SortedMap[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
+
+// =============================================================================
+// ITERATOR DENGAN PAGINATION: DAFTAR USER
+// =============================================================================
+
+// User adalah data user dari pelajaran 11
+type User struct {
+	ID   string
+	Nama string
+}
+
+// UserDB mensimulasikan database yang hanya bisa dibaca per halaman
+type UserDB struct {
+	users   []User
+	queries int // Jumlah query yang dijalankan, untuk melihat efek lazy
+	failAt  int // Halaman yang gagal (0 = tidak pernah gagal)
+}
+
+// ErrDBDown adalah error simulasi koneksi database putus
+var ErrDBDown = errors.New("koneksi database terputus")
+
+// FindUser mencari satu user berdasarkan ID
+func (db *UserDB) FindUser(id string) (User, error) {
+	for _, u := range db.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return User{}, fmt.Errorf("user %s tidak ditemukan", id)
+}
+
+// fetchPage mengambil satu halaman user (halaman dimulai dari 1)
+func (db *UserDB) fetchPage(page, size int) ([]User, error) {
+	db.queries++
+	if page == db.failAt {
+		return nil, fmt.Errorf("halaman %d: %w", page, ErrDBDown)
+	}
+	start := (page - 1) * size
+	if start >= len(db.users) {
+		return nil, nil
+	}
+	end := min(start+size, len(db.users))
+	return db.users[start:end], nil
+}
+
+// AllUsers mengiterasi SEMUA user, mengambil halaman berikutnya hanya jika
+// dibutuhkan. Jika loop berhenti di halaman 1, halaman 2 tidak pernah di-query.
+// Error dikirim sebagai nilai kedua (pola iter.Seq2[V, error]).
+func (db *UserDB) AllUsers(pageSize int) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		for page := 1; ; page++ {
+			users, err := db.fetchPage(page, pageSize)
+			if err != nil {
+				yield(User{}, err)
+				return
+			}
+			if len(users) == 0 {
+				return // Halaman kosong: data habis
+			}
+			for _, u := range users {
+				if !yield(u, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// =============================================================================
+// CLEANUP DI ITERATOR
+// =============================================================================
+
+// Lines mengiterasi baris teks. defer di dalam iterator tetap dijalankan
+// walau loop pemanggil berhenti dengan break (misal untuk menutup file).
+func Lines(text string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		fmt.Println("    [Lines] buka sumber data")
+		defer fmt.Println("    [Lines] tutup sumber data")
+
+		n := 0
+		for line := range strings.Lines(text) {
+			n++
+			if !yield(n, strings.TrimRight(line, "\n")) {
+				return
+			}
+		}
+	}
+}
+
+// badIterator SALAH: tetap memanggil yield walau sudah menerima false
+func badIterator(yield func(int) bool) {
+	for i := range 3 {
+		yield(i) // Mengabaikan nilai kembalian yield!
+	}
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("ITERATOR (RANGE OVER FUNC)")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. CLOSURE counter() VS ITERATOR Counter()
+	// =============================================================================
+	fmt.Println("--- 1. Closure counter() vs Iterator Counter() ---")
+
+	next := counter()
+	fmt.Printf("Closure  : %d %d %d (dipanggil manual)\n", next(), next(), next())
+
+	fmt.Print("Iterator : ")
+	for n := range Counter(1) {
+		if n > 3 {
+			break // Counter tidak pernah habis, break menghentikannya
+		}
+		fmt.Print(n, " ")
+	}
+	fmt.Println("(di-range langsung)")
+
+	// Range over int (Go 1.22+) untuk perbandingan
+	fmt.Print("range 3  : ")
+	for i := range 3 {
+		fmt.Print(i, " ")
+	}
+	fmt.Println()
+
+	// =============================================================================
+	// 2. FIBONACCI DAN KOMPOSISI ITERATOR
+	// =============================================================================
+	fmt.Println("\n--- 2. Fibonacci, Take, Filter ---")
+
+	fmt.Print("Fibonacci < 100: ")
+	for f := range Fibonacci() {
+		if f >= 100 {
+			break
+		}
+		fmt.Print(f, " ")
+	}
+	fmt.Println()
+
+	// Iterator bisa dirangkai: tidak ada slice perantara yang dibuat
+	genap := Filter(Fibonacci(), func(n int) bool { return n%2 == 0 })
+	fmt.Printf("5 Fibonacci genap pertama: %v\n", slices.Collect(Take(genap, 5)))
+	fmt.Printf("10 Fibonacci pertama     : %v\n", slices.Collect(Take(Fibonacci(), 10)))
+
+	// =============================================================================
+	// 3. MAP DENGAN KEY TERURUT
+	// =============================================================================
+	fmt.Println("\n--- 3. Map nilai dengan Key Terurut ---")
+
+	nilai := map[string]int{
+		"Matematika": 90,
+		"Fisika":     85,
+		"Kimia":      88,
+	}
+
+	for pelajaran, n := range SortedMap(nilai) {
+		fmt.Printf("  %-10s: %d\n", pelajaran, n)
+	}
+
+	// Dengan fungsi standar: maps.Keys → slices.Sorted
+	fmt.Printf("slices.Sorted(maps.Keys(nilai))   = %v\n", slices.Sorted(maps.Keys(nilai)))
+	fmt.Printf("slices.Sorted(maps.Values(nilai)) = %v\n", slices.Sorted(maps.Values(nilai)))
+
+	// maps.Collect mengubah iter.Seq2 kembali menjadi map
+	lulus := maps.Collect(func(yield func(string, bool) bool) {
+		for p, n := range SortedMap(nilai) {
+			if !yield(p, n >= 88) {
+				return
+			}
+		}
+	})
+	fmt.Printf("maps.Collect → lulus[Fisika]=%v, lulus[Kimia]=%v\n", lulus["Fisika"], lulus["Kimia"])
+
+	// =============================================================================
+	// 4. PAGINATION YANG LAZY
+	// =============================================================================
+	fmt.Println("\n--- 4. Daftar User dengan Pagination ---")
+
+	db := &UserDB{users: []User{
+		{"001", "Budi"}, {"002", "Ani"}, {"003", "Caca"}, {"004", "Dedi"},
+		{"005", "Eka"}, {"006", "Fani"}, {"007", "Gilang"},
+	}}
+
+	u, err := db.FindUser("003")
+	fmt.Printf("FindUser(003) = %v, err = %v\n", u, err)
+
+	fmt.Println("Semua user (3 per halaman):")
+	for u, err := range db.AllUsers(3) {
+		if err != nil {
+			fmt.Printf("  Error: %v\n", err)
+			break
+		}
+		fmt.Printf("  %s %s\n", u.ID, u.Nama)
+	}
+	fmt.Printf("Query dijalankan: %d (3 halaman berisi + 1 halaman kosong)\n", db.queries)
+
+	db.queries = 0
+	fmt.Print("Cari user pertama berawalan 'C': ")
+	for u, err := range db.AllUsers(2) {
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			break
+		}
+		if strings.HasPrefix(u.Nama, "C") {
+			fmt.Printf("%s %s\n", u.ID, u.Nama)
+			break // Halaman 3 dan 4 tidak pernah di-query
+		}
+	}
+	fmt.Printf("Query dijalankan: %d\n", db.queries)
+
+	// Error di tengah iterasi dikirim sebagai nilai kedua
+	db.failAt = 2
+	fmt.Println("Database gagal di halaman 2:")
+	for u, err := range db.AllUsers(3) {
+		if err != nil {
+			fmt.Printf("  Error: %v (errors.Is ErrDBDown = %v)\n", err, errors.Is(err, ErrDBDown))
+			break
+		}
+		fmt.Printf("  %s %s\n", u.ID, u.Nama)
+	}
+	db.failAt = 0
+
+	// =============================================================================
+	// 5. iter.Pull: MENGAMBIL NILAI SATU PER SATU
+	// =============================================================================
+	fmt.Println("\n--- 5. iter.Pull ---")
+
+	nextFib, stop := iter.Pull(Fibonacci())
+	defer stop() // Wajib: melepas iterator yang belum selesai
+
+	for range 3 {
+		v, ok := nextFib()
+		fmt.Printf("next() = %d, ok = %v\n", v, ok)
+	}
+
+	// Pull berguna untuk memasangkan dua iterator (zip)
+	nextName, stopName := iter.Pull(slices.Values([]string{"Budi", "Ani", "Caca"}))
+	defer stopName()
+	for nomor := range Counter(1) {
+		name, ok := nextName()
+		if !ok {
+			break // Nama habis
+		}
+		fmt.Printf("  Peserta %d: %s\n", nomor, name)
+	}
+
+	// =============================================================================
+	// 6. SEMANTIK break DAN CLEANUP
+	// =============================================================================
+	fmt.Println("\n--- 6. Break, Cleanup, dan Iterator yang Salah ---")
+
+	teks := "baris satu\nbaris dua\nbaris tiga\nbaris empat\n"
+	fmt.Println("  Berhenti di baris 2:")
+	for n, line := range Lines(teks) {
+		fmt.Printf("    %d: %s\n", n, line)
+		if n == 2 {
+			break
+		}
+	}
+	fmt.Println("  → defer di iterator tetap jalan setelah break")
+
+	// Iterator yang mengabaikan false dari yield menyebabkan panic
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Printf("  badIterator panic: %v\n", r)
+			}
+		}()
+		for i := range badIterator {
+			if i == 0 {
+				break
+			}
+		}
+	}()
+
+	// =============================================================================
+	// 7. ITERATOR DARI LIBRARY STANDAR
+	// =============================================================================
+	fmt.Println("\n--- 7. Iterator di Library Standar ---")
+
+	buah := []string{"apel", "jeruk", "mangga"}
+	for i, b := range slices.Backward(buah) {
+		fmt.Printf("  Backward: %d=%s\n", i, b)
+	}
+
+	fmt.Printf("maps.Collect(slices.All(buah)) = %v\n", maps.Collect(slices.All(buah)))
+	fmt.Printf("slices.Chunk(1..7, 3): ")
+	for chunk := range slices.Chunk([]int{1, 2, 3, 4, 5, 6, 7}, 3) {
+		fmt.Print(chunk, " ")
+	}
+	fmt.Println()
+
+	fmt.Print("strings.SplitSeq: ")
+	for part := range strings.SplitSeq("go,iter,seq", ",") {
+		fmt.Printf("[%s] ", part)
+	}
+	fmt.Println()
+
+	fmt.Print("strings.FieldsSeq: ")
+	for word := range strings.FieldsSeq("  belajar   go  iterator ") {
+		fmt.Printf("[%s] ", word)
+	}
+	fmt.Println()
+
+	// =============================================================================
+	// 8. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 8. Best Practices Iterator ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Selalu cek nilai kembalian yield dan berhenti jika false")
+	fmt.Println("   - Gunakan iter.Seq2[V, error] untuk iterasi yang bisa gagal")
+	fmt.Println("   - defer stop() setelah iter.Pull")
+	fmt.Println("   - Pakai iterator untuk data besar / lazy (pagination, file)")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Membuat iterator untuk slice kecil yang cukup di-range biasa")
+	fmt.Println("   - Menyimpan fungsi yield dan memanggilnya setelah iterator selesai")
+	fmt.Println("   - Lupa bahwa iterator tak terbatas butuh break")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - for v := range seq: body loop adalah fungsi yield")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,480 @@
+/*
+================================================================================
+PELAJARAN 26: STRUCTURED LOGGING DENGAN log/slog
+================================================================================
+
+MASALAH DENGAN fmt.Printf
+-------------------------
+KurangiStok di pelajaran 09 mencetak "ERROR: Stok tidak mencukupi!" dan
+pelajaran 11 mencetak error dengan fmt.Printf. Masalahnya:
+- Tidak ada level: pesan penting tercampur dengan pesan debug
+- Tidak ada struktur: sulit dicari/difilter oleh tool (grep, Loki, ELK)
+- Tidak bisa dimatikan atau diarahkan ke tempat lain tanpa mengubah kode
+
+STRUCTURED LOGGING
+------------------
+Setiap log berisi PESAN + pasangan KEY=VALUE:
+
+    fmt.Printf("Stok %s berkurang %d\n", sku, n)          ← teks bebas
+    logger.Info("stok berkurang", "sku", sku, "jumlah", n) ← terstruktur
+
+TextHandler:  time=... level=INFO msg="stok berkurang" sku=P001 jumlah=2
+JSONHandler:  {"time":"...","level":"INFO","msg":"stok berkurang","sku":"P001","jumlah":2}
+
+KOMPONEN slog
+-------------
+┌──────────────────┬─────────────────────────────────────────────────────┐
+│ slog.Logger      │ Yang dipanggil kode: Info, Warn, Error, Debug, With │
+│ slog.Handler     │ Yang memformat & menulis: TextHandler, JSONHandler, │
+│                  │ atau handler buatan sendiri                         │
+│ slog.Attr        │ Satu pasangan key-value: slog.String("sku", "P001") │
+│ slog.LogValuer   │ Tipe yang menentukan sendiri cara ia di-log         │
+└──────────────────┴─────────────────────────────────────────────────────┘
+
+LEVEL
+-----
+┌────────────┬───────┬──────────────────────────────────────────────┐
+│ Level      │ Angka │ Kapan dipakai                                │
+├────────────┼───────┼──────────────────────────────────────────────┤
+│ LevelDebug │  -4   │ Detail untuk developer, biasanya dimatikan   │
+│ LevelInfo  │   0   │ Kejadian normal (default)                    │
+│ LevelWarn  │   4   │ Tidak normal tapi program tetap jalan        │
+│ LevelError │   8   │ Operasi gagal dan perlu perhatian            │
+└────────────┴───────┴──────────────────────────────────────────────┘
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+Waktu dihapus dari log (ReplaceAttr) agar output selalu sama:
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// =============================================================================
+// LogValuer: MENYAMARKAN DATA PRIBADI
+// =============================================================================
+
+// Person dari pelajaran 09. Alamat adalah data pribadi yang tidak boleh
+// tercatat utuh di log.
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+}
+
+// LogValue dipanggil slog saat Person di-log, sehingga alamat SELALU
+// disamarkan di handler mana pun tanpa perlu diingat oleh pemanggil
+func (p Person) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("nama", p.Nama),
+		slog.Int("umur", p.Umur),
+		slog.String("alamat", samarkan(p.Alamat)),
+	)
+}
+
+// samarkan menyisakan 3 huruf pertama: "Surabaya" → "Sur*****"
+func samarkan(s string) string {
+	r := []rune(s)
+	if len(r) <= 3 {
+		return strings.Repeat("*", len(r))
+	}
+	return string(r[:3]) + strings.Repeat("*", len(r)-3)
+}
+
+// =============================================================================
+// KurangiStok DENGAN LOGGER
+// =============================================================================
+
+// Product dari pelajaran 09, ditambah SKU
+type Product struct {
+	SKU      string
+	Nama     string
+	Stok     int
+	Tersedia bool
+}
+
+// ErrStokKurang dikembalikan jika stok tidak mencukupi
+var ErrStokKurang = errors.New("stok tidak mencukupi")
+
+// Inventory menyimpan produk dan logger-nya. Logger diterima dari luar
+// (dependency injection) sehingga bisa diganti saat test.
+type Inventory struct {
+	products map[string]*Product
+	log      *slog.Logger
+}
+
+// NewInventory membuat Inventory. Setiap log dari Inventory membawa
+// atribut component=inventory.
+func NewInventory(logger *slog.Logger, products ...*Product) *Inventory {
+	inv := &Inventory{
+		products: make(map[string]*Product),
+		log:      logger.With(slog.String("component", "inventory")),
+	}
+	for _, p := range products {
+		inv.products[p.SKU] = p
+	}
+	return inv
+}
+
+// KurangiStok menggantikan fmt.Println("ERROR: ...") dengan log berlevel
+// dan tetap mengembalikan error ke pemanggil
+func (inv *Inventory) KurangiStok(ctx context.Context, sku string, jumlah int) error {
+	logger := inv.log.With(slog.String("sku", sku))
+
+	prod, ok := inv.products[sku]
+	if !ok {
+		logger.ErrorContext(ctx, "produk tidak ditemukan")
+		return fmt.Errorf("kurangi stok %s: produk tidak ditemukan", sku)
+	}
+	if jumlah > prod.Stok {
+		logger.WarnContext(ctx, "stok tidak mencukupi",
+			slog.Int("diminta", jumlah),
+			slog.Int("tersedia", prod.Stok))
+		return fmt.Errorf("kurangi stok %s: %w", sku, ErrStokKurang)
+	}
+
+	prod.Stok -= jumlah
+	logger.InfoContext(ctx, "stok berkurang", slog.Int("jumlah", jumlah), slog.Int("sisa", prod.Stok))
+	if prod.Stok == 0 {
+		prod.Tersedia = false
+		logger.WarnContext(ctx, "stok habis", slog.String("nama", prod.Nama))
+	}
+	return nil
+}
+
+// =============================================================================
+// HANDLER KUSTOM BERBAHASA INDONESIA
+// =============================================================================
+
+// IndoOptions mengatur IndoHandler
+type IndoOptions struct {
+	Level      slog.Leveler // Level minimum, default LevelInfo
+	TanpaWaktu bool         // Jangan tulis waktu (untuk output yang bisa dibandingkan)
+}
+
+// IndoHandler menulis log satu baris dengan tanggal format Indonesia
+// dan nama level dalam bahasa Indonesia:
+//
+//	19-10-2026 14:05:09 PERINGATAN stok tidak mencukupi | sku=P003 diminta=5
+type IndoHandler struct {
+	opts   IndoOptions
+	mu     *sync.Mutex // Dipakai bersama oleh semua turunan WithAttrs/WithGroup
+	w      io.Writer
+	attrs  string // Atribut dari WithAttrs yang sudah diformat
+	prefix string // Prefix grup dari WithGroup, misal "request."
+}
+
+// NewIndoHandler membuat IndoHandler yang menulis ke w
+func NewIndoHandler(w io.Writer, opts *IndoOptions) *IndoHandler {
+	h := &IndoHandler{w: w, mu: &sync.Mutex{}}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.Level == nil {
+		h.opts.Level = slog.LevelInfo
+	}
+	return h
+}
+
+// namaLevel menerjemahkan level ke bahasa Indonesia
+func namaLevel(l slog.Level) string {
+	switch {
+	case l >= slog.LevelError:
+		return "KESALAHAN"
+	case l >= slog.LevelWarn:
+		return "PERINGATAN"
+	case l >= slog.LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+
+func (h *IndoHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.opts.Level.Level()
+}
+
+func (h *IndoHandler) Handle(_ context.Context, r slog.Record) error {
+	var buf strings.Builder
+	if !h.opts.TanpaWaktu && !r.Time.IsZero() {
+		buf.WriteString(r.Time.Format("02-01-2006 15:04:05 "))
+	}
+	fmt.Fprintf(&buf, "%-10s %s", namaLevel(r.Level), r.Message)
+
+	var attrs strings.Builder
+	attrs.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		appendAttr(&attrs, h.prefix, a)
+		return true
+	})
+	if attrs.Len() > 0 {
+		buf.WriteString(" |")
+		buf.WriteString(attrs.String())
+	}
+	buf.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, buf.String())
+	return err
+}
+
+func (h *IndoHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	h2 := *h // Salin: handler lama tidak boleh berubah
+	var b strings.Builder
+	b.WriteString(h.attrs)
+	for _, a := range attrs {
+		appendAttr(&b, h.prefix, a)
+	}
+	h2.attrs = b.String()
+	return &h2
+}
+
+func (h *IndoHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// appendAttr menulis " key=value". Grup diratakan menjadi "grup.key=value".
+func appendAttr(b *strings.Builder, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve() // Memanggil LogValue() jika ada
+	if a.Equal(slog.Attr{}) {
+		return // Attr kosong diabaikan (aturan slog.Handler)
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendAttr(b, prefix, ga)
+		}
+		return
+	}
+
+	val := a.Value.String()
+	if strings.ContainsAny(val, " =\"") || val == "" {
+		val = strconv.Quote(val)
+	}
+	fmt.Fprintf(b, " %s%s=%s", prefix, a.Key, val)
+}
+
+// =============================================================================
+// HELPER
+// =============================================================================
+
+// tanpaWaktu adalah ReplaceAttr yang menghapus atribut waktu bawaan handler.
+// Trik ini umum dipakai di test dan contoh agar output selalu sama.
+func tanpaWaktu(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("STRUCTURED LOGGING DENGAN log/slog")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	ctx := context.Background()
+	textOpts := &slog.HandlerOptions{ReplaceAttr: tanpaWaktu}
+
+	// =============================================================================
+	// 1. DARI fmt.Printf DAN log KE slog
+	// =============================================================================
+	fmt.Println("--- 1. fmt.Printf vs log vs slog ---")
+
+	fmt.Println("ERROR: Stok tidak mencukupi!") // Cara pelajaran 09
+
+	// Package log klasik: ada prefix dan waktu, tapi tetap teks bebas
+	classic := log.New(os.Stdout, "[gudang] ", 0)
+	classic.Printf("stok %s tidak mencukupi: diminta %d, tersedia %d", "P003", 5, 0)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, textOpts))
+	logger.Warn("stok tidak mencukupi", "sku", "P003", "diminta", 5, "tersedia", 0)
+
+	// =============================================================================
+	// 2. TextHandler DAN JSONHandler
+	// =============================================================================
+	// Kode logging SAMA, hanya handler yang berbeda
+	fmt.Println("\n--- 2. TextHandler dan JSONHandler ---")
+
+	textLogger := slog.New(slog.NewTextHandler(os.Stdout, textOpts))
+	jsonLogger := slog.New(slog.NewJSONHandler(os.Stdout, textOpts))
+
+	for _, l := range []*slog.Logger{textLogger, jsonLogger} {
+		l.Info("user login", "user_id", "001", "nama", "Budi Santoso", "durasi", 1500*time.Millisecond)
+	}
+
+	// =============================================================================
+	// 3. LEVEL
+	// =============================================================================
+	fmt.Println("\n--- 3. Level ---")
+
+	// LevelVar bisa diubah saat program berjalan (misal lewat endpoint admin)
+	level := new(slog.LevelVar) // Default: LevelInfo
+	leveled := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: level, ReplaceAttr: tanpaWaktu}))
+
+	leveled.Debug("tidak tampil: di bawah Info")
+	leveled.Info("tampil")
+	level.Set(slog.LevelDebug)
+	leveled.Debug("tampil setelah level.Set(LevelDebug)")
+	level.Set(slog.LevelError)
+	leveled.Warn("tidak tampil: di bawah Error")
+	leveled.Error("hanya Error yang tampil")
+
+	// Cek dulu sebelum menghitung data log yang mahal
+	if leveled.Enabled(ctx, slog.LevelDebug) {
+		leveled.Debug("laporan mahal", "isi", strings.Repeat("x", 1000))
+	} else {
+		fmt.Println("Enabled(Debug) = false → laporan mahal tidak dihitung")
+	}
+
+	// =============================================================================
+	// 4. ATRIBUT
+	// =============================================================================
+	fmt.Println("\n--- 4. Atribut ---")
+
+	// Pasangan bebas "key", value... (mudah, tapi salah ketik tidak ketahuan)
+	textLogger.Info("pasangan bebas", "sku", "P001", "stok", 10)
+
+	// slog.String/Int/...: tipe jelas, lebih cepat, dicek oleh go vet
+	textLogger.Info("slog.Attr", slog.String("sku", "P001"), slog.Int("stok", 10), slog.Bool("tersedia", true))
+
+	// Key tanpa value → !BADKEY. go vet menolak textLogger.Info("...", "sku"),
+	// jadi argumennya dikirim lewat slice agar contoh ini tetap bisa di-compile
+	ganjil := []any{"sku"}
+	textLogger.Info("argumen ganjil", ganjil...)
+
+	// With: atribut yang menempel di semua log berikutnya
+	reqLogger := textLogger.With(slog.String("request_id", "REQ-7f3a"))
+	reqLogger.Info("mulai proses")
+	reqLogger.Info("selesai", slog.Duration("durasi", 250*time.Millisecond))
+
+	// LogAttrs: versi paling efisien, hanya menerima slog.Attr
+	textLogger.LogAttrs(ctx, slog.LevelInfo, "LogAttrs", slog.Float64("harga", 15000000))
+
+	// =============================================================================
+	// 5. GRUP
+	// =============================================================================
+	fmt.Println("\n--- 5. Grup ---")
+
+	for _, l := range []*slog.Logger{textLogger, jsonLogger} {
+		l.Info("request",
+			slog.Group("http", slog.String("method", "GET"), slog.Int("status", 200)),
+			slog.String("path", "/products/P001"))
+	}
+
+	// WithGroup: semua atribut berikutnya masuk ke grup "db"
+	jsonLogger.WithGroup("db").Info("query", slog.String("tabel", "users"), slog.Int("baris", 3))
+
+	// =============================================================================
+	// 6. LogValuer MENYAMARKAN ALAMAT
+	// =============================================================================
+	fmt.Println("\n--- 6. LogValuer pada Person ---")
+
+	budi := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Surabaya"}
+	textLogger.Info("registrasi", "person", budi)
+	jsonLogger.Info("registrasi", slog.Any("person", budi))
+	fmt.Printf("fmt tetap melihat data asli: %+v\n", budi)
+
+	// =============================================================================
+	// 7. KurangiStok DENGAN LOGGER
+	// =============================================================================
+	fmt.Println("\n--- 7. KurangiStok dengan slog ---")
+
+	inv := NewInventory(textLogger,
+		&Product{SKU: "P001", Nama: "Laptop Gaming", Stok: 3, Tersedia: true},
+		&Product{SKU: "P003", Nama: "Headset", Stok: 0},
+	)
+	for _, req := range []struct {
+		sku    string
+		jumlah int
+	}{{"P001", 2}, {"P001", 1}, {"P003", 5}, {"P999", 1}} {
+		if err := inv.KurangiStok(ctx, req.sku, req.jumlah); err != nil {
+			fmt.Printf("  → pemanggil menerima error: %v\n", err)
+		}
+	}
+
+	// =============================================================================
+	// 8. HANDLER KUSTOM BERBAHASA INDONESIA
+	// =============================================================================
+	fmt.Println("\n--- 8. Handler Kustom (IndoHandler) ---")
+
+	// Panggil Handle langsung dengan waktu tetap untuk melihat format tanggal
+	indo := NewIndoHandler(os.Stdout, nil)
+	rec := slog.NewRecord(time.Date(2025, time.August, 17, 10, 0, 0, 0, time.UTC), slog.LevelInfo, "upacara dimulai", 0)
+	rec.AddAttrs(slog.String("lokasi", "Jakarta"))
+	indo.Handle(ctx, rec)
+
+	indoLogger := slog.New(NewIndoHandler(os.Stdout, &IndoOptions{Level: slog.LevelDebug, TanpaWaktu: true}))
+	indoLogger.Debug("koneksi dibuka", "host", "localhost:5432")
+	indoLogger.Info("registrasi", "person", budi)
+	indoLogger.With("component", "api").WithGroup("http").Warn("request lambat",
+		slog.String("path", "/users"), slog.Duration("durasi", 2*time.Second))
+	indoLogger.Error("gagal menyimpan", "error", ErrStokKurang, "catatan", "coba lagi nanti")
+
+	NewInventory(indoLogger, &Product{SKU: "P002", Nama: "Mouse", Stok: 1}).KurangiStok(ctx, "P002", 1)
+
+	// slog.SetDefault juga mengarahkan package log klasik ke handler ini
+	defaultLama := slog.Default()
+	slog.SetDefault(indoLogger)
+	log.Printf("pesan dari log.Printf lama, user=%s", "Ani")
+	slog.Info("slog.Info memakai default logger")
+	slog.SetDefault(defaultLama)
+
+	// =============================================================================
+	// 9. MENANGKAP LOG UNTUK TEST
+	// =============================================================================
+	// Karena Inventory menerima *slog.Logger, test cukup memberi logger yang
+	// menulis ke bytes.Buffer lalu memeriksa setiap record. Lihat main_test.go.
+	fmt.Println("\n--- 9. Menangkap Log di Test ---")
+
+	fmt.Println("  1. Buat logger: slog.New(slog.NewJSONHandler(&buf, nil))")
+	fmt.Println("  2. Suntikkan ke kode yang diuji: NewInventory(logger, ...)")
+	fmt.Println("  3. Pecah buf per baris dengan json.Unmarshal, periksa level/msg/atribut")
+	fmt.Println("  Jalankan: go test -v ./26_logging")
+
+	// =============================================================================
+	// 10. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 10. Best Practices Logging ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Pesan log singkat dan tetap, data di atribut: \"stok berkurang\", sku=...")
+	fmt.Println("   - Terima *slog.Logger sebagai dependency agar mudah diuji")
+	fmt.Println("   - JSONHandler di production, TextHandler saat development")
+	fmt.Println("   - Implementasikan LogValuer untuk tipe yang berisi data sensitif")
+	fmt.Println("   - Jalankan go vet: memeriksa pasangan key-value slog")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - fmt.Sprintf di pesan log (\"stok \" + sku + \" habis\")")
+	fmt.Println("   - Log DAN return error yang sama di setiap lapisan (log ganda)")
+	fmt.Println("   - Mencatat password, token, atau data pribadi apa adanya")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Log adalah data: pesan + atribut, bukan kalimat bebas")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// parseLogs memecah output JSONHandler menjadi satu map per baris
+func parseLogs(t *testing.T, r io.Reader) []map[string]any {
+	t.Helper()
+	var entries []map[string]any
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var m map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			t.Fatalf("log bukan JSON: %v\n%s", err, scanner.Text())
+		}
+		entries = append(entries, m)
+	}
+	return entries
+}
+
+// newTestInventory membuat Inventory dengan satu produk (stok 1) yang
+// log-nya ditulis sebagai JSON ke buf
+func newTestInventory(buf *bytes.Buffer) *Inventory {
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return NewInventory(logger, &Product{SKU: "P001", Nama: "Laptop", Stok: 1, Tersedia: true})
+}
+
+func TestKurangiStokLog(t *testing.T) {
+	tests := []struct {
+		name    string
+		sku     string
+		jumlah  int
+		wantErr bool
+		wantIs  error            // Sentinel yang harus dibungkus error, jika ada
+		want    []map[string]any // Atribut yang harus ada di setiap record, berurutan
+	}{
+		{
+			name:    "stok kurang",
+			sku:     "P001",
+			jumlah:  5,
+			wantErr: true,
+			wantIs:  ErrStokKurang,
+			want: []map[string]any{
+				{"level": "WARN", "msg": "stok tidak mencukupi", "component": "inventory",
+					"sku": "P001", "diminta": 5.0, "tersedia": 1.0}, // Angka JSON → float64
+			},
+		},
+		{
+			name:   "stok habis",
+			sku:    "P001",
+			jumlah: 1,
+			want: []map[string]any{
+				{"level": "INFO", "msg": "stok berkurang", "sku": "P001", "jumlah": 1.0, "sisa": 0.0},
+				{"level": "WARN", "msg": "stok habis", "sku": "P001", "nama": "Laptop"},
+			},
+		},
+		{
+			name:    "produk tidak ada",
+			sku:     "P999",
+			jumlah:  1,
+			wantErr: true,
+			want: []map[string]any{
+				{"level": "ERROR", "msg": "produk tidak ditemukan", "sku": "P999"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := newTestInventory(&buf).KurangiStok(context.Background(), tt.sku, tt.jumlah)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KurangiStok error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Errorf("KurangiStok error = %v, want %v", err, tt.wantIs)
+			}
+
+			entries := parseLogs(t, &buf)
+			if len(entries) != len(tt.want) {
+				t.Fatalf("jumlah log = %d, want %d\n%s", len(entries), len(tt.want), buf.String())
+			}
+			for i, want := range tt.want {
+				for key, v := range want {
+					if got := entries[i][key]; got != v {
+						t.Errorf("log[%d] %s = %v, want %v", i, key, got, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPersonLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("data pribadi", "person", Person{Nama: "Budi", Umur: 25, Alamat: "Surabaya"})
+
+	if strings.Contains(buf.String(), "Surabaya") {
+		t.Errorf("alamat bocor ke log: %s", buf.String())
+	}
+	entries := parseLogs(t, &buf)
+	if len(entries) != 1 {
+		t.Fatalf("jumlah log = %d, want 1", len(entries))
+	}
+	person, ok := entries[0]["person"].(map[string]any)
+	if !ok {
+		t.Fatalf("person = %v, want grup JSON", entries[0]["person"])
+	}
+	if person["alamat"] != "Sur*****" {
+		t.Errorf("person.alamat = %v, want Sur*****", person["alamat"])
+	}
+}
+
+func TestIndoHandler(t *testing.T) {
+	tests := []struct {
+		name string
+		log  func(l *slog.Logger)
+		want string
+	}{
+		{
+			name: "info dengan atribut",
+			log:  func(l *slog.Logger) { l.Info("registrasi", "user", "Ani") },
+			want: "INFO       registrasi | user=Ani\n",
+		},
+		{
+			name: "debug di bawah level minimum",
+			log:  func(l *slog.Logger) { l.Debug("koneksi dibuka") },
+			want: "",
+		},
+		{
+			name: "WithAttrs dan WithGroup",
+			log: func(l *slog.Logger) {
+				l.With("component", "api").WithGroup("http").Warn("request lambat", "path", "/users")
+			},
+			want: "PERINGATAN request lambat | component=api http.path=/users\n",
+		},
+		{
+			name: "nilai berspasi dikutip",
+			log:  func(l *slog.Logger) { l.Error("gagal menyimpan", "catatan", "coba lagi") },
+			want: "KESALAHAN  gagal menyimpan | catatan=\"coba lagi\"\n",
+		},
+		{
+			name: "LogValuer di-resolve",
+			log: func(l *slog.Logger) {
+				l.Info("registrasi", "person", Person{Nama: "Budi", Umur: 25, Alamat: "Surabaya"})
+			},
+			want: "INFO       registrasi | person.nama=Budi person.umur=25 person.alamat=Sur*****\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.log(slog.New(NewIndoHandler(&buf, &IndoOptions{TanpaWaktu: true})))
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamarkan(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Surabaya", "Sur*****"},
+		{"Abc", "***"},
+		{"", ""},
+		{"Bogor", "Bog**"},
+	}
+	for _, tt := range tests {
+		if got := samarkan(tt.in); got != tt.want {
+			t.Errorf("samarkan(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,478 @@
+/*
+================================================================================
+PELAJARAN 27: REFLECTION (PACKAGE reflect)
+================================================================================
+
+Pelajaran 09 memakai %+v dan %T untuk mencetak struct tanpa menjelaskan
+bagaimana fmt bisa "melihat" nama field. Jawabannya: REFLECTION, yaitu
+kemampuan program memeriksa tipe dan nilai saat runtime.
+
+DUA KONSEP UTAMA
+----------------
+┌──────────────────┬────────────────────────────────────────────────────────┐
+│ reflect.Type     │ Informasi TIPE: nama, kind, field, tag, method         │
+│                  │ t := reflect.TypeOf(x)                                 │
+│ reflect.Value    │ NILAI yang bisa dibaca (dan kadang diubah)             │
+│                  │ v := reflect.ValueOf(x)                                │
+└──────────────────┴────────────────────────────────────────────────────────┘
+
+Type vs Kind:
+    type Person struct{...}  → Type = main.Person, Kind = struct
+    type Rupiah float64      → Type = main.Rupiah, Kind = float64
+
+METHOD PENTING
+--------------
+┌────────────────────────────┬──────────────────────────────────────────────┐
+│ t.NumField() / t.Field(i)  │ Daftar field (StructField: Name, Type, Tag)  │
+│ reflect.VisibleFields(t)   │ Termasuk field yang dipromosikan dari embed  │
+│ t.NumMethod() / t.Method(i)│ Method yang diekspor                         │
+│ field.Tag.Get("json")      │ Membaca struct tag                           │
+│ v.Elem()                   │ Nilai yang ditunjuk pointer                  │
+│ v.CanSet() / v.SetInt(n)   │ Mengubah nilai (harus lewat pointer)         │
+│ v.Interface()              │ Kembali dari reflect.Value ke any            │
+└────────────────────────────┴──────────────────────────────────────────────┘
+
+HUKUM REFLECTION
+----------------
+1. Dari interface ke reflect object: reflect.ValueOf(x)
+2. Dari reflect object ke interface: v.Interface()
+3. Untuk MENGUBAH nilai, Value harus settable: kirim POINTER, lalu Elem()
+
+PERINGATAN
+----------
+Reflection LAMBAT, tidak dicek compiler (salah nama field = panic saat
+runtime), dan sulit dibaca. Pakai hanya untuk kode generik seperti encoding
+(json, fmt), ORM, atau validator. Ukur sendiri (benchmark ada di
+reflect_test.go):
+    go test -bench . -benchmem ./27_reflect
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// =============================================================================
+// STRUCT DARI PELAJARAN 09 (DITAMBAH TAG)
+// =============================================================================
+
+// Person dengan struct tag untuk json dan validasi
+type Person struct {
+	Nama   string `json:"nama" validate:"required"`
+	Umur   int    `json:"umur" validate:"min=0,max=150"`
+	Alamat string `json:"alamat,omitempty"`
+}
+
+// Perkenalan memakai value receiver: ada di method set Person dan *Person
+func (p Person) Perkenalan() string {
+	return fmt.Sprintf("Halo, nama saya %s, umur %d tahun", p.Nama, p.Umur)
+}
+
+// IsAdult mengecek umur dewasa (18 tahun ke atas)
+func (p Person) IsAdult() bool {
+	return p.Umur >= 18
+}
+
+// Birthday memakai pointer receiver: hanya ada di method set *Person
+func (p *Person) Birthday() {
+	p.Umur++
+}
+
+// Address adalah nested struct di Employee
+type Address struct {
+	Jalan   string `json:"jalan"`
+	Kota    string `json:"kota"`
+	KodePos string `json:"kode_pos"`
+}
+
+// Employee memiliki Address sebagai field biasa (bernama)
+type Employee struct {
+	Nama    string  `json:"nama"`
+	Umur    int     `json:"umur"`
+	Address Address `json:"address"`
+}
+
+// Manager meng-embed Person: field dan method Person dipromosikan
+type Manager struct {
+	Person
+	Department string `json:"department"`
+	bawahan    int    // unexported: bisa dibaca reflect, tidak bisa diubah
+}
+
+// Rupiah menunjukkan perbedaan Type dan Kind
+type Rupiah float64
+
+// =============================================================================
+// PRETTY-PRINTER YANG MENIRU %+v
+// =============================================================================
+
+// Sprint menghasilkan string yang sama dengan fmt.Sprintf("%+v", x) untuk
+// tipe dasar, struct, pointer ke struct, slice, dan map.
+// (fmt asli juga menangani Stringer, error, channel, dll.)
+func Sprint(x any) string {
+	if x == nil {
+		return "<nil>"
+	}
+	var b strings.Builder
+	writeValue(&b, reflect.ValueOf(x), true)
+	return b.String()
+}
+
+// writeValue menulis satu nilai secara rekursif.
+// top menandakan nilai paling luar: pointer ke struct dicetak &{...}
+// hanya di level teratas, di dalam struct dicetak sebagai alamat (0xc000...).
+func writeValue(b *strings.Builder, v reflect.Value, top bool) {
+	switch v.Kind() {
+	case reflect.String:
+		b.WriteString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		b.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+
+	case reflect.Struct:
+		t := v.Type()
+		b.WriteByte('{')
+		for i := range v.NumField() {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(t.Field(i).Name)
+			b.WriteByte(':')
+			writeValue(b, v.Field(i), false) // Field unexported tetap bisa DIBACA
+		}
+		b.WriteByte('}')
+
+	case reflect.Pointer:
+		switch {
+		case v.IsNil():
+			b.WriteString("<nil>")
+		case top && v.Elem().Kind() == reflect.Struct:
+			b.WriteByte('&')
+			writeValue(b, v.Elem(), false)
+		default:
+			fmt.Fprintf(b, "0x%x", v.Pointer())
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteByte('[')
+		for i := range v.Len() {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			writeValue(b, v.Index(i), false)
+		}
+		b.WriteByte(']')
+
+	case reflect.Map:
+		// fmt mengurutkan key map agar output stabil
+		keys := v.MapKeys()
+		slices.SortFunc(keys, compareKey)
+		b.WriteString("map[")
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			writeValue(b, k, false)
+			b.WriteByte(':')
+			writeValue(b, v.MapIndex(k), false)
+		}
+		b.WriteByte(']')
+
+	case reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("<nil>")
+			return
+		}
+		writeValue(b, v.Elem(), false)
+
+	default:
+		fmt.Fprintf(b, "?%s?", v.Kind())
+	}
+}
+
+// compareKey mengurutkan key map: angka secara numerik, string secara
+// leksikal, tipe lain berdasarkan hasil Sprint-nya
+func compareKey(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	default:
+		return cmp.Compare(Sprint(a.Interface()), Sprint(b.Interface()))
+	}
+}
+
+// =============================================================================
+// HELPER
+// =============================================================================
+
+// cetakField mencetak setiap field struct beserta tipe dan tag-nya
+func cetakField(t reflect.Type) {
+	fmt.Printf("%s (%d field):\n", t, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		info := ""
+		if f.Anonymous {
+			info += " [embedded]"
+		}
+		if !f.IsExported() {
+			info += " [unexported]"
+		}
+		if f.Tag != "" {
+			info += fmt.Sprintf(" tag=`%s`", f.Tag)
+		}
+		fmt.Printf("  %d. %-10s %-12s%s\n", i, f.Name, f.Type, info)
+	}
+}
+
+// cetakMethod mencetak method set dari sebuah tipe
+func cetakMethod(t reflect.Type) {
+	names := make([]string, 0, t.NumMethod())
+	for i := range t.NumMethod() {
+		m := t.Method(i)
+		names = append(names, m.Name+signature(m.Type))
+	}
+	fmt.Printf("  %-14s %d method: %s\n", t.String(), t.NumMethod(), strings.Join(names, ", "))
+}
+
+// signature memformat tipe method tanpa receiver: "(int) string".
+// Untuk method dari reflect.Type, parameter pertama (In(0)) adalah receiver.
+func signature(t reflect.Type) string {
+	var in, out []string
+	for i := 1; i < t.NumIn(); i++ {
+		in = append(in, t.In(i).String())
+	}
+	for i := range t.NumOut() {
+		out = append(out, t.Out(i).String())
+	}
+	sig := "(" + strings.Join(in, ", ") + ")"
+	switch len(out) {
+	case 0:
+	case 1:
+		sig += " " + out[0]
+	default:
+		sig += " (" + strings.Join(out, ", ") + ")"
+	}
+	return sig
+}
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("REFLECTION")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	person := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+	employee := Employee{
+		Nama: "Ani Wijaya",
+		Umur: 28,
+		Address: Address{
+			Jalan:   "Jl. Sudirman No. 123",
+			Kota:    "Jakarta",
+			KodePos: "12190",
+		},
+	}
+	manager := Manager{
+		Person:     Person{Nama: "Direktur", Umur: 45, Alamat: "Kantor Pusat"},
+		Department: "IT",
+		bawahan:    12,
+	}
+
+	// =============================================================================
+	// 1. %T DAN reflect.TypeOf
+	// =============================================================================
+	fmt.Printf("--- 1. %%T, Type, dan Kind ---\n")
+
+	values := []any{person, &person, employee, manager, Rupiah(15000), 42, "teks", []string{"a"}, map[string]int{}}
+	for _, x := range values {
+		t := reflect.TypeOf(x)
+		fmt.Printf("  %%T = %-18s Type.String() = %-18s Kind = %s\n", fmt.Sprintf("%T", x), t, t.Kind())
+	}
+
+	// =============================================================================
+	// 2. DAFTAR FIELD DAN TAG
+	// =============================================================================
+	fmt.Println("\n--- 2. Field dan Struct Tag ---")
+
+	cetakField(reflect.TypeOf(person))
+	cetakField(reflect.TypeOf(employee))
+	cetakField(reflect.TypeOf(manager))
+
+	// Membaca tag tertentu
+	umurField, _ := reflect.TypeOf(person).FieldByName("Umur")
+	fmt.Printf("Tag json Umur     : %q\n", umurField.Tag.Get("json"))
+	fmt.Printf("Tag validate Umur : %q\n", umurField.Tag.Get("validate"))
+	if _, ok := umurField.Tag.Lookup("xml"); !ok {
+		fmt.Println("Tag xml Umur      : tidak ada (Lookup membedakan kosong dan tidak ada)")
+	}
+
+	// =============================================================================
+	// 3. NESTED STRUCT: MENELUSURI Employee.Address
+	// =============================================================================
+	fmt.Println("\n--- 3. Menelusuri Nested Struct ---")
+
+	var telusuri func(v reflect.Value, indent string)
+	telusuri = func(v reflect.Value, indent string) {
+		t := v.Type()
+		for i := range v.NumField() {
+			f, fv := t.Field(i), v.Field(i)
+			if fv.Kind() == reflect.Struct {
+				fmt.Printf("%s%s (%s):\n", indent, f.Name, f.Type)
+				telusuri(fv, indent+"  ")
+				continue
+			}
+			fmt.Printf("%s%s = %v\n", indent, f.Name, fv)
+		}
+	}
+	telusuri(reflect.ValueOf(employee), "  ")
+
+	// FieldByIndex: jalur [2 1] = field ke-2 (Address), lalu field ke-1 (Kota)
+	kota := reflect.ValueOf(employee).FieldByIndex([]int{2, 1})
+	fmt.Printf("FieldByIndex([2 1]) = %v\n", kota)
+
+	// =============================================================================
+	// 4. FIELD YANG DIPROMOSIKAN (EMBEDDED Manager)
+	// =============================================================================
+	fmt.Println("\n--- 4. Promoted Field pada Manager ---")
+
+	// NumField hanya melihat 3 field langsung; VisibleFields menyertakan
+	// field Person yang dipromosikan beserta jalur Index-nya
+	for _, f := range reflect.VisibleFields(reflect.TypeOf(manager)) {
+		jenis := "langsung"
+		if len(f.Index) > 1 {
+			jenis = "dipromosikan dari Person"
+		}
+		fmt.Printf("  %-10s Index=%-6s %s\n", f.Name, fmt.Sprint(f.Index), jenis)
+	}
+
+	nama := reflect.ValueOf(manager).FieldByName("Nama") // Otomatis mencari di Person
+	fmt.Printf("FieldByName(\"Nama\") = %v (sama dengan manager.Nama = %s)\n", nama, manager.Nama)
+
+	// =============================================================================
+	// 5. METHOD SET: T VS *T
+	// =============================================================================
+	// Method dengan pointer receiver (Birthday) hanya ada di method set *T
+	fmt.Println("\n--- 5. Method dan Method Set ---")
+
+	cetakMethod(reflect.TypeOf(person))
+	cetakMethod(reflect.TypeOf(&person))
+	cetakMethod(reflect.TypeOf(manager))  // Method Person ikut dipromosikan
+	cetakMethod(reflect.TypeOf(&manager)) // Termasuk Birthday dari *Person
+
+	// Memanggil method lewat reflection
+	m := reflect.ValueOf(manager).MethodByName("Perkenalan")
+	hasil := m.Call(nil)
+	fmt.Printf("MethodByName(\"Perkenalan\").Call() → %q\n", hasil[0])
+
+	// =============================================================================
+	// 6. MENGUBAH FIELD LEWAT POINTER
+	// =============================================================================
+	fmt.Println("\n--- 6. Mengubah Field lewat Pointer ---")
+
+	v := reflect.ValueOf(person)
+	fmt.Printf("ValueOf(person).Field(1).CanSet()  = %v (salinan, tidak bisa diubah)\n", v.Field(1).CanSet())
+
+	pv := reflect.ValueOf(&person).Elem() // Elem: dari pointer ke struct aslinya
+	fmt.Printf("ValueOf(&person).Elem().CanSet()  = %v\n", pv.Field(1).CanSet())
+
+	pv.FieldByName("Umur").SetInt(26)
+	pv.FieldByName("Alamat").SetString("Bandung")
+	fmt.Printf("Setelah SetInt/SetString: %+v\n", person)
+
+	// Set dengan tipe salah → panic. Cek dulu dengan Kind atau CanSet.
+	setField := func(ptr any, name string, value any) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}()
+		f := reflect.ValueOf(ptr).Elem().FieldByName(name)
+		if !f.IsValid() {
+			return fmt.Errorf("field %q tidak ada", name)
+		}
+		if !f.CanSet() {
+			return fmt.Errorf("field %q tidak bisa diubah (unexported?)", name)
+		}
+		f.Set(reflect.ValueOf(value))
+		return nil
+	}
+	fmt.Printf("setField(Department, \"HR\") → %v\n", setField(&manager, "Department", "HR"))
+	fmt.Printf("setField(Umur, \"tua\")      → %v\n", setField(&manager, "Umur", "tua"))
+	fmt.Printf("setField(bawahan, 20)      → %v\n", setField(&manager, "bawahan", 20))
+	fmt.Printf("setField(Gaji, 1000)       → %v\n", setField(&manager, "Gaji", 1000))
+	fmt.Printf("manager.Department = %s\n", manager.Department)
+
+	// =============================================================================
+	// 7. PRETTY-PRINTER YANG MENIRU %+v
+	// =============================================================================
+	fmt.Printf("\n--- 7. Sprint: Meniru %%+v dengan reflect ---\n")
+
+	samples := []any{
+		person,
+		&person,
+		employee,
+		manager,
+		Rupiah(15000.5),
+		[]Person{{Nama: "A", Umur: 1}, {Nama: "B", Umur: 2}},
+		map[string]int{"Kimia": 88, "Fisika": 85},
+		map[int]string{10: "sepuluh", 9: "sembilan"},
+		struct {
+			Tags   []string
+			Kosong *Address
+		}{Tags: []string{"go", "reflect"}},
+		nil,
+	}
+	sama := 0
+	for _, x := range samples {
+		got, want := Sprint(x), fmt.Sprintf("%+v", x)
+		status := "BEDA"
+		if got == want {
+			status = "SAMA"
+			sama++
+		}
+		fmt.Printf("  %s %s\n", status, got)
+	}
+	fmt.Printf("Hasil: %d/%d sama dengan fmt.Sprintf(\"%%+v\")\n", sama, len(samples))
+
+	// =============================================================================
+	// 8. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 8. Best Practices Reflection ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Pakai reflection untuk library generik (encoding, ORM, validator)")
+	fmt.Println("   - Cek Kind, IsValid, dan CanSet sebelum mengakses nilai")
+	fmt.Println("   - Cache hasil reflect.Type per tipe jika dipanggil berulang kali")
+	fmt.Println("   - Pertimbangkan generics (pelajaran 15) lebih dulu")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Memakai reflection di hot path tanpa mengukur (go test -bench .)")
+	fmt.Println("   - Mengakses field dengan nama string jika bisa langsung p.Nama")
+	fmt.Println("   - Lupa bahwa kesalahan reflection baru ketahuan saat runtime (panic)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Reflection: kuat, tapi lambat dan tidak dicek compiler")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// =============================================================================
+// BENCHMARK: AKSES LANGSUNG VS REFLECTION
+// =============================================================================
+// Jalankan dengan: go test -bench . -benchmem ./27_reflect
+// Bandingkan pasangan yang namanya sama, misal BenchmarkGet/langsung dan
+// BenchmarkGet/reflect_Field.
+
+// sink mencegah compiler membuang hasil perhitungan benchmark
+var sink any
+
+// umurIndex adalah index field Umur di Person
+const umurIndex = 1
+
+func BenchmarkGet(b *testing.B) {
+	p := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+
+	b.Run("langsung", func(b *testing.B) {
+		for b.Loop() {
+			sink = p.Umur
+		}
+	})
+	b.Run("reflect_Field", func(b *testing.B) {
+		for b.Loop() {
+			sink = reflect.ValueOf(p).Field(umurIndex).Int()
+		}
+	})
+	b.Run("reflect_FieldByName", func(b *testing.B) {
+		for b.Loop() {
+			sink = reflect.ValueOf(p).FieldByName("Umur").Int()
+		}
+	})
+}
+
+func BenchmarkSet(b *testing.B) {
+	p := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+
+	b.Run("langsung", func(b *testing.B) {
+		for b.Loop() {
+			p.Umur = 30
+		}
+	})
+	b.Run("reflect_SetInt", func(b *testing.B) {
+		for b.Loop() {
+			reflect.ValueOf(&p).Elem().Field(umurIndex).SetInt(30)
+		}
+	})
+}
+
+func BenchmarkFormat(b *testing.B) {
+	p := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+
+	b.Run("fmt.Sprintf", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = fmt.Sprintf("%+v", p)
+		}
+	})
+	b.Run("Sprint_reflect_buatan", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = Sprint(p)
+		}
+	})
+	b.Run("manual", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = "{Nama:" + p.Nama + " Umur:" + strconv.Itoa(p.Umur) + " Alamat:" + p.Alamat + "}"
+		}
+	})
+}
+
+// =============================================================================
+// TEST: Sprint SAMA DENGAN fmt.Sprintf("%+v")
+// =============================================================================
+
+func TestSprint(t *testing.T) {
+	person := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+	alamat := &Address{Jalan: "Jl. Merdeka 1", Kota: "Bandung", KodePos: "40111"}
+
+	tests := []struct {
+		name string
+		x    any
+	}{
+		{"nil", nil},
+		{"string", "halo"},
+		{"int negatif", -42},
+		{"float", 3.25},
+		{"bool", true},
+		{"tipe bernama", Rupiah(15000.5)},
+		{"struct", person},
+		{"struct kosong", Person{}},
+		{"pointer ke struct", &person},
+		{"nested struct", Employee{Nama: "Ani", Umur: 30, Address: *alamat}},
+		{"embedded dan unexported", Manager{Person: person, Department: "IT", bawahan: 3}},
+		{"pointer di dalam struct", struct{ Alamat *Address }{alamat}},
+		{"pointer nil di dalam struct", struct{ Alamat *Address }{}},
+		{"slice struct", []Person{{Nama: "A", Umur: 1}, {Nama: "B", Umur: 2}}},
+		{"slice pointer", []*Address{alamat, nil}},
+		{"slice nil", []string(nil)},
+		{"slice kosong", []int{}},
+		{"map diurutkan key string", map[string]int{"Kimia": 88, "Fisika": 85, "Biologi": 90}},
+		{"map diurutkan key int", map[int]string{10: "sepuluh", 9: "sembilan", -1: "minus"}},
+		{"map berisi struct", map[string]Address{"rumah": *alamat}},
+		{"map nil", map[string]int(nil)},
+		{"struct berisi slice dan map", struct {
+			Tags  []string
+			Nilai map[string]float64
+		}{[]string{"go", "reflect"}, map[string]float64{"uts": 80.5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := Sprint(tt.x), fmt.Sprintf("%+v", tt.x); got != want {
+				t.Errorf("Sprint = %s, want %s", got, want)
+			}
+		})
+	}
+}
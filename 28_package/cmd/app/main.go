@@ -0,0 +1,57 @@
+// Command app adalah contoh program kecil yang dibangun dari package
+// models dan internal/store. Jalankan dari root repository:
+//
+//	go run ./28_package/cmd/app
+//	go build -o app ./28_package/cmd/app && ./app
+//
+// Konvensi Go: setiap program (package main) diletakkan di cmd/<nama>/,
+// sedangkan logika yang bisa dipakai ulang ada di package lain.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"learn-go/28_package/internal/store"
+	"learn-go/28_package/models"
+)
+
+func main() {
+	s := store.New()
+	for _, p := range []models.Product{
+		models.NewProduct("P001", "Laptop Gaming", 15000000, 10),
+		models.NewProduct("P002", "Mouse Wireless", 250000, 50),
+		models.NewProduct("P003", "Headset", 500000, 0),
+	} {
+		if err := s.Tambah(p); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+	}
+
+	pesanan := []struct {
+		sku    string
+		jumlah int
+	}{{"P001", 2}, {"P003", 1}, {"P009", 1}}
+
+	for _, o := range pesanan {
+		err := s.Beli(o.sku, o.jumlah)
+		switch {
+		case err == nil:
+			fmt.Printf("OK     beli %d x %s\n", o.jumlah, o.sku)
+		case errors.Is(err, models.ErrStokKurang):
+			fmt.Printf("HABIS  %v\n", err)
+		case errors.Is(err, store.ErrNotFound):
+			fmt.Printf("TIDAK  %v\n", err)
+		default:
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Println("\nStok akhir:")
+	for _, p := range s.Semua() {
+		fmt.Printf("  %s %-15s %3d\n", p.SKU, p.Nama, p.Stok())
+	}
+}
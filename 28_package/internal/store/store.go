@@ -0,0 +1,94 @@
+// Package store menyimpan Product di memory.
+//
+// Karena berada di bawah direktori internal/, package ini HANYA bisa diimpor
+// oleh package di dalam learn-go/28_package/... (misal cmd/app dan main.go
+// pelajaran 28). Package di luar, seperti learn-go/29_worker_pool, akan
+// gagal di-compile:
+//
+//	use of internal package learn-go/28_package/internal/store not allowed
+package store
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"learn-go/28_package/internal/urutan"
+	"learn-go/28_package/models"
+)
+
+// ErrNotFound dikembalikan jika SKU tidak ada di store
+var ErrNotFound = errors.New("produk tidak ditemukan")
+
+// ErrDuplikat dikembalikan jika SKU sudah terdaftar
+var ErrDuplikat = errors.New("SKU sudah terdaftar")
+
+func init() {
+	urutan.Catat("store: init() (setelah models selesai)")
+}
+
+// Store adalah penyimpanan produk yang aman dipakai banyak goroutine
+type Store struct {
+	mu       sync.RWMutex
+	products map[string]*models.Product
+}
+
+// New membuat Store kosong. Nama New (bukan NewStore) karena pemanggil
+// sudah menulis nama package: store.New() terbaca lebih baik dari
+// store.NewStore().
+func New() *Store {
+	return &Store{products: make(map[string]*models.Product)}
+}
+
+// Tambah mendaftarkan produk baru
+func (s *Store) Tambah(p models.Product) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.products[p.SKU]; ok {
+		return fmt.Errorf("tambah %s: %w", p.SKU, ErrDuplikat)
+	}
+	s.products[p.SKU] = &p
+	return nil
+}
+
+// Cari mengembalikan salinan produk berdasarkan SKU
+func (s *Store) Cari(sku string) (models.Product, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.products[sku]
+	if !ok {
+		return models.Product{}, fmt.Errorf("cari %s: %w", sku, ErrNotFound)
+	}
+	return *p, nil
+}
+
+// Beli mengurangi stok produk. Error dari models (ErrStokKurang) diteruskan
+// dengan %w sehingga tetap bisa dicek dengan errors.Is oleh pemanggil.
+func (s *Store) Beli(sku string, jumlah int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.products[sku]
+	if !ok {
+		return fmt.Errorf("beli %s: %w", sku, ErrNotFound)
+	}
+	if err := p.KurangiStok(jumlah); err != nil {
+		return fmt.Errorf("beli: %w", err)
+	}
+	return nil
+}
+
+// Semua mengembalikan salinan semua produk, urut SKU
+func (s *Store) Semua() []models.Product {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]models.Product, 0, len(s.products))
+	for _, p := range s.products {
+		result = append(result, *p)
+	}
+	slices.SortFunc(result, func(a, b models.Product) int {
+		return strings.Compare(a.SKU, b.SKU)
+	})
+	return result
+}
@@ -0,0 +1,22 @@
+// Package urutan mencatat urutan inisialisasi package (variabel level
+// package dan fungsi init) agar bisa ditampilkan oleh pelajaran 28.
+//
+// Package ini tidak mengimpor package lain dari pelajaran, sehingga SELALU
+// diinisialisasi paling awal.
+package urutan
+
+var langkah []string
+
+// Catat menambahkan satu langkah inisialisasi dan mengembalikan nomornya.
+// Mengembalikan nilai agar bisa dipanggil dari inisialisasi variabel:
+//
+//	var x = urutan.Catat("models: var x")
+func Catat(s string) int {
+	langkah = append(langkah, s)
+	return len(langkah)
+}
+
+// Semua mengembalikan salinan semua langkah yang sudah dicatat
+func Semua() []string {
+	return append([]string(nil), langkah...)
+}
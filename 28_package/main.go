@@ -0,0 +1,259 @@
+/*
+================================================================================
+PELAJARAN 28: PACKAGE, MODULE, internal/, init(), DAN go.work
+================================================================================
+
+Pelajaran 08 menjelaskan nama exported (huruf besar) vs unexported (huruf
+kecil), tetapi semua pelajaran sejauh ini adalah satu file package main.
+Di sini Person dan Product dipindah ke package sendiri lalu DIIMPOR.
+
+ISTILAH
+-------
+┌──────────────┬──────────────────────────────────────────────────────────────┐
+│ Package      │ Satu direktori berisi file .go dengan nama package yang sama │
+│ Module       │ Kumpulan package dengan satu go.mod (di sini: learn-go)      │
+│ Import path  │ Path module + direktori: learn-go/28_package/models          │
+│ Workspace    │ go.work: beberapa module lokal dikembangkan bersamaan        │
+└──────────────┴──────────────────────────────────────────────────────────────┘
+
+STRUKTUR DIREKTORI
+------------------
+    28_package/
+    ├── main.go               ← pelajaran ini (package main)
+    ├── models/               ← package models: Person, Product
+    │   ├── person.go
+    │   └── product.go
+    ├── internal/
+    │   ├── store/            ← hanya boleh diimpor dari dalam 28_package/
+    │   └── urutan/           ← pencatat urutan inisialisasi
+    ├── cmd/app/              ← program kedua: go run ./28_package/cmd/app
+    └── workspace/            ← go.work + module lain (lihat bagian 6)
+
+VISIBILITAS
+-----------
+┌───────────────────────────┬─────────────────────────────────────────────────┐
+│ models.Person, p.Nama     │ Exported: bisa dipakai package lain             │
+│ p.nik, jumlahPerson       │ Unexported: hanya di dalam package models       │
+│ internal/store            │ Hanya untuk package di bawah induk internal/    │
+└───────────────────────────┴─────────────────────────────────────────────────┘
+
+URUTAN INISIALISASI
+-------------------
+1. Package yang diimpor diinisialisasi lebih dulu (sekali saja, walau
+   diimpor banyak package)
+2. Dalam satu package: semua variabel level package (sesuai dependensi,
+   lalu urutan deklarasi), KEMUDIAN semua fungsi init() sesuai urutan file
+3. Terakhir: main.main()
+
+VERSI MODULE (SEMANTIC VERSIONING)
+----------------------------------
+    v1.2.3 = MAJOR.MINOR.PATCH
+    PATCH  perbaikan bug, API sama
+    MINOR  fitur baru, API lama tetap jalan
+    MAJOR  perubahan yang merusak → path module berubah: example.com/greet/v2
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"learn-go/28_package/internal/store"
+	"learn-go/28_package/internal/urutan"
+	"learn-go/28_package/models"
+)
+
+// =============================================================================
+// VARIABEL LEVEL PACKAGE DAN init()
+// =============================================================================
+
+// katalog diinisialisasi sebelum init() di bawah, tetapi SETELAH semua
+// package yang diimpor (models, store) selesai diinisialisasi
+var katalog = buatKatalog()
+
+func buatKatalog() *store.Store {
+	urutan.Catat("main: var katalog")
+	return store.New()
+}
+
+// Boleh ada lebih dari satu init() dalam satu package, bahkan satu file
+func init() {
+	urutan.Catat("main: init() pertama")
+}
+
+func init() {
+	urutan.Catat("main: init() kedua")
+	for _, p := range []models.Product{
+		models.NewProduct("P001", "Laptop Gaming", 15000000, 10),
+		models.NewProduct("P002", "Mouse Wireless", 250000, 50),
+		models.NewProduct("P003", "Headset", 500000, 0),
+	} {
+		if err := katalog.Tambah(p); err != nil {
+			panic(err) // data awal salah = bug program, bukan error runtime
+		}
+	}
+}
+
+// =============================================================================
+// MAIN FUNCTION
+// =============================================================================
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("PACKAGE, MODULE, DAN WORKSPACE")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. URUTAN INISIALISASI
+	// =============================================================================
+	fmt.Println("--- 1. Urutan Inisialisasi ---")
+
+	urutan.Catat("main: main()")
+	for i, s := range urutan.Semua() {
+		fmt.Printf("  %2d. %s\n", i+1, s)
+	}
+	fmt.Println("Catatan: models hanya diinisialisasi SEKALI walau diimpor oleh store dan main")
+
+	// =============================================================================
+	// 2. IDENTIFIER EXPORTED VS UNEXPORTED
+	// =============================================================================
+	fmt.Println("\n--- 2. Exported vs Unexported ---")
+
+	budi := models.NewPerson("Budi Santoso", 25, "Jakarta", "3171012345678901")
+	fmt.Println("Person       :", budi)
+	fmt.Println("budi.Nama    :", budi.Nama)
+	fmt.Println("NIKTersamar():", budi.NIKTersamar())
+	fmt.Printf("IsAdult()    : %t (UmurDewasa = %d)\n", budi.IsAdult(), models.UmurDewasa)
+
+	// Baris berikut TIDAK bisa di-compile karena nik unexported:
+	//   fmt.Println(budi.nik)
+	//   → budi.nik undefined (cannot refer to unexported field nik)
+	//
+	// Literal struct dari package lain juga tidak bisa mengisi nik:
+	//   models.Person{Nama: "Ani", nik: "123"}
+	//   → unknown field nik in struct literal of type models.Person
+	ani := models.Person{Nama: "Ani Wijaya", Umur: 17}
+	fmt.Printf("Literal tanpa constructor: %s, NIK = %q\n", ani, ani.NIKTersamar())
+	fmt.Println("JumlahPerson():", models.JumlahPerson(), "(hanya NewPerson yang menghitung)")
+
+	// =============================================================================
+	// 3. PACKAGE-LEVEL STATE LEWAT API
+	// =============================================================================
+	fmt.Println("\n--- 3. Mengubah Data Lewat Method Exported ---")
+
+	laptop, _ := katalog.Cari("P001")
+	fmt.Printf("%s stok awal: %d\n", laptop.Nama, laptop.Stok())
+	// laptop.stok = 999 tidak bisa; satu-satunya jalan adalah KurangiStok
+	if err := laptop.KurangiStok(3); err != nil {
+		fmt.Println("error:", err)
+	}
+	fmt.Printf("Salinan lokal setelah KurangiStok(3): %d\n", laptop.Stok())
+	asli, _ := katalog.Cari("P001")
+	fmt.Printf("Data di store tetap: %d (Cari mengembalikan salinan)\n", asli.Stok())
+
+	// =============================================================================
+	// 4. ERROR SENTINEL LINTAS PACKAGE
+	// =============================================================================
+	fmt.Println("\n--- 4. Error Sentinel Lintas Package ---")
+
+	pesanan := []struct {
+		sku    string
+		jumlah int
+	}{{"P001", 2}, {"P003", 1}, {"P009", 1}}
+
+	for _, o := range pesanan {
+		err := katalog.Beli(o.sku, o.jumlah)
+		switch {
+		case err == nil:
+			fmt.Printf("  OK     beli %d x %s\n", o.jumlah, o.sku)
+		case errors.Is(err, models.ErrStokKurang):
+			fmt.Printf("  HABIS  %v\n", err)
+		case errors.Is(err, store.ErrNotFound):
+			fmt.Printf("  TIDAK  %v\n", err)
+		}
+	}
+	err := katalog.Tambah(models.NewProduct("P001", "Duplikat", 1, 1))
+	fmt.Println("  Tambah P001 lagi:", err, "→ errors.Is ErrDuplikat:", errors.Is(err, store.ErrDuplikat))
+
+	fmt.Println("Stok akhir:")
+	for _, p := range katalog.Semua() {
+		fmt.Printf("  %s %-15s %3d  tersedia=%t\n", p.SKU, p.Nama, p.Stok(), p.Tersedia())
+	}
+
+	// =============================================================================
+	// 5. internal/ DAN cmd/
+	// =============================================================================
+	fmt.Println("\n--- 5. Direktori internal/ dan cmd/ ---")
+
+	fmt.Println("learn-go/28_package/internal/store boleh diimpor oleh:")
+	fmt.Println("  ✓ learn-go/28_package            (main.go ini)")
+	fmt.Println("  ✓ learn-go/28_package/cmd/app")
+	fmt.Println("  ✗ learn-go/29_worker_pool        → use of internal package ... not allowed")
+	fmt.Println()
+	fmt.Println("Program lain dalam module yang sama diletakkan di cmd/<nama>/:")
+	fmt.Println("  go run ./28_package/cmd/app")
+	fmt.Println("  go build -o app ./28_package/cmd/app")
+
+	// =============================================================================
+	// 6. WORKSPACE (go.work) DAN VERSI MODULE
+	// =============================================================================
+	fmt.Println("\n--- 6. Workspace go.work dan Versi Module ---")
+
+	fmt.Println("28_package/workspace/ berisi tiga module terpisah:")
+	fmt.Println("  greet/     module example.com/greet     (v1)")
+	fmt.Println("  greet/v2/  module example.com/greet/v2  (v2, API berubah)")
+	fmt.Println("  hello/     module example.com/hello     (memakai keduanya)")
+	fmt.Println()
+	fmt.Println("go.work menyatukan module lokal tanpa publish atau replace di go.mod:")
+	fmt.Println("  cd 28_package/workspace")
+	fmt.Println("  go work init ./greet ./greet/v2 ./hello   # sudah dibuat")
+	fmt.Println("  go run ./hello")
+	fmt.Println()
+	fmt.Println("Module yang punya go.mod sendiri TIDAK ikut 'go build ./...' dari root learn-go.")
+
+	fmt.Println("\nPerubahan API dan versinya:")
+	perubahan := []struct {
+		contoh string
+		versi  string
+	}{
+		{"Tambah fungsi HelloSemua", "v1.0.0 → v1.1.0"},
+		{"Hello memangkas spasi (bug fix)", "v1.1.0 → v1.1.1"},
+		{"Tandai Salam dengan // Deprecated:", "v1.1.1 → v1.2.0"},
+		{"Hello(nama) → Hello(nama, opts) (string, error)", "v1.x   → v2.0.0 (/v2)"},
+		{"Hapus Salam", "v1.x   → v2.0.0 (/v2)"},
+	}
+	for _, p := range perubahan {
+		fmt.Printf("  %-48s %s\n", p.contoh, p.versi)
+	}
+	fmt.Println("Import keduanya sekaligus dengan alias:")
+	fmt.Println(`  greetv1 "example.com/greet"`)
+	fmt.Println(`  greetv2 "example.com/greet/v2"`)
+
+	// =============================================================================
+	// 7. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 7. Best Practices Package ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Beri nama package pendek, huruf kecil, tanpa underscore (models, store)")
+	fmt.Println("   - Hindari pengulangan: store.New(), bukan store.NewStore()")
+	fmt.Println("   - Sembunyikan detail di internal/ dan field unexported")
+	fmt.Println("   - Tulis doc comment untuk setiap identifier exported")
+	fmt.Println("   - Naikkan MAJOR (dan path /vN) setiap kali API rusak")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Package bernama util, common, atau helpers")
+	fmt.Println("   - Logika berat atau I/O di init() (sulit dites, urutan tersembunyi)")
+	fmt.Println("   - Import cycle: models tidak boleh mengimpor store yang mengimpor models")
+	fmt.Println("   - Mengandalkan go.work saat rilis: pengguna module tidak membacanya")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Package: unit kode, Module: unit versi, Workspace: unit pengembangan")
+	fmt.Println("================================================================================")
+}
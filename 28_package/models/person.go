@@ -0,0 +1,65 @@
+// Package models berisi tipe data Person dan Product dari pelajaran 09,
+// kini dalam package sendiri sehingga bisa diimpor oleh package lain.
+//
+// Aturan visibilitas berlaku di sini: hanya nama yang diawali huruf BESAR
+// (Person, NewPerson, Nama) yang bisa dipakai dari luar package models.
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"learn-go/28_package/internal/urutan"
+)
+
+// UmurDewasa adalah konstanta exported, bisa dibaca sebagai models.UmurDewasa
+const UmurDewasa = 18
+
+// jumlahPerson adalah variabel level package yang unexported: hanya bisa
+// diubah oleh kode di dalam package models (misal lewat NewPerson)
+var jumlahPerson int
+
+// Blank identifier: dievaluasi saat inisialisasi hanya untuk mencatat urutan
+var _ = urutan.Catat("models: var _ (person.go)")
+
+func init() {
+	urutan.Catat("models: init() di person.go")
+}
+
+// Person adalah data seseorang. Field nik unexported sehingga package lain
+// tidak bisa membaca atau mengubahnya secara langsung.
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+	nik    string
+}
+
+// NewPerson adalah constructor: satu-satunya cara package lain mengisi nik
+func NewPerson(nama string, umur int, alamat, nik string) Person {
+	jumlahPerson++
+	return Person{Nama: nama, Umur: umur, Alamat: alamat, nik: nik}
+}
+
+// JumlahPerson mengembalikan berapa kali NewPerson dipanggil
+func JumlahPerson() int {
+	return jumlahPerson
+}
+
+// IsAdult mengecek apakah umur sudah mencapai UmurDewasa
+func (p Person) IsAdult() bool {
+	return p.Umur >= UmurDewasa
+}
+
+// NIKTersamar mengembalikan NIK dengan hanya 4 digit terakhir yang terlihat
+func (p Person) NIKTersamar() string {
+	if len(p.nik) <= 4 {
+		return p.nik
+	}
+	return strings.Repeat("*", len(p.nik)-4) + p.nik[len(p.nik)-4:]
+}
+
+// String membuat Person enak dicetak dengan fmt (interface fmt.Stringer)
+func (p Person) String() string {
+	return fmt.Sprintf("%s (%d tahun, %s)", p.Nama, p.Umur, p.Alamat)
+}
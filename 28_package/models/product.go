@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"learn-go/28_package/internal/urutan"
+)
+
+// ErrStokKurang dikembalikan KurangiStok jika stok tidak mencukupi.
+// Error sentinel diekspor agar pemanggil bisa memakai errors.Is.
+var ErrStokKurang = errors.New("stok tidak mencukupi")
+
+var _ = urutan.Catat("models: var _ (product.go)")
+
+func init() {
+	urutan.Catat("models: init() di product.go")
+}
+
+// Product adalah barang dagangan. Stok unexported agar hanya bisa diubah
+// lewat method yang menjaga aturan (stok tidak boleh negatif).
+type Product struct {
+	SKU   string
+	Nama  string
+	Harga float64
+	stok  int
+}
+
+// NewProduct membuat Product dengan stok awal
+func NewProduct(sku, nama string, harga float64, stok int) Product {
+	return Product{SKU: sku, Nama: nama, Harga: harga, stok: stok}
+}
+
+// Stok adalah getter untuk field stok. Konvensi Go: nama getter tanpa "Get".
+func (p Product) Stok() int {
+	return p.stok
+}
+
+// Tersedia bernilai true jika stok masih ada
+func (p Product) Tersedia() bool {
+	return p.stok > 0
+}
+
+// KurangiStok mengurangi stok atau mengembalikan ErrStokKurang
+func (p *Product) KurangiStok(jumlah int) error {
+	if jumlah > p.stok {
+		return fmt.Errorf("%s: diminta %d, tersedia %d: %w", p.SKU, jumlah, p.stok, ErrStokKurang)
+	}
+	p.stok -= jumlah
+	return nil
+}
@@ -0,0 +1,36 @@
+// Package greet adalah modul kedua di workspace pelajaran 28 (versi v1).
+//
+// Riwayat versi (semantic versioning):
+//
+//	v1.0.0  Hello(nama string) string
+//	v1.1.0  + HelloSemua (fitur baru, tidak merusak → naik MINOR)
+//	v1.1.1  Hello memangkas spasi (perbaikan bug → naik PATCH)
+//	v1.2.0  Salam ditandai Deprecated (API tetap ada → naik MINOR)
+//
+// Perubahan yang merusak pemanggil lama ada di modul example.com/greet/v2.
+package greet
+
+import "strings"
+
+// Hello mengembalikan salam untuk satu nama
+func Hello(nama string) string {
+	return "Halo, " + strings.TrimSpace(nama) + "!"
+}
+
+// HelloSemua mengembalikan salam untuk banyak nama sekaligus.
+// Ditambahkan di v1.1.0: menambah fungsi baru aman bagi pemanggil lama.
+func HelloSemua(nama ...string) []string {
+	result := make([]string, 0, len(nama))
+	for _, n := range nama {
+		result = append(result, Hello(n))
+	}
+	return result
+}
+
+// Salam adalah nama lama dari Hello.
+//
+// Deprecated: pakai Hello. Salam tetap ada agar kode lama tidak rusak;
+// fungsi exported di v1 tidak boleh dihapus.
+func Salam(nama string) string {
+	return Hello(nama)
+}
@@ -0,0 +1,41 @@
+// Package greet versi v2 mengubah signature Hello sehingga TIDAK kompatibel
+// dengan v1. Karena itu path modulnya berakhiran /v2 (aturan "semantic
+// import versioning"): v1 dan v2 dianggap modul berbeda dan bisa dipakai
+// bersamaan dalam satu program.
+//
+// Perubahan dari v1:
+//
+//	Hello(nama string) string
+//	→ Hello(nama string, opts Options) (string, error)
+//	Salam (deprecated) dihapus
+package greet
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNamaKosong dikembalikan Hello jika nama kosong
+var ErrNamaKosong = errors.New("greet: nama kosong")
+
+// Options mengatur format salam
+type Options struct {
+	Sapaan string // default "Halo"
+	Formal bool   // tambahkan "Bapak/Ibu"
+}
+
+// Hello mengembalikan salam, atau error jika nama kosong
+func Hello(nama string, opts Options) (string, error) {
+	nama = strings.TrimSpace(nama)
+	if nama == "" {
+		return "", ErrNamaKosong
+	}
+	sapaan := opts.Sapaan
+	if sapaan == "" {
+		sapaan = "Halo"
+	}
+	if opts.Formal {
+		nama = "Bapak/Ibu " + nama
+	}
+	return sapaan + ", " + nama + "!", nil
+}
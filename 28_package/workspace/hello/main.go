@@ -0,0 +1,28 @@
+// Command hello memakai dua versi modul greet sekaligus. Modul-modul ini
+// tidak dipublikasikan; go.work di direktori atasnya membuat Go mencari
+// example.com/greet dan example.com/greet/v2 di disk:
+//
+//	cd 28_package/workspace
+//	go run ./hello
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	greetv1 "example.com/greet"
+	greetv2 "example.com/greet/v2"
+)
+
+func main() {
+	fmt.Println("v1:", greetv1.Hello("Budi"))
+	for _, s := range greetv1.HelloSemua("Ani", " Citra ") {
+		fmt.Println("v1:", s)
+	}
+
+	s, err := greetv2.Hello("Budi", greetv2.Options{Sapaan: "Selamat pagi", Formal: true})
+	fmt.Println("v2:", s, err)
+
+	_, err = greetv2.Hello("  ", greetv2.Options{})
+	fmt.Println("v2: nama kosong →", err, errors.Is(err, greetv2.ErrNamaKosong))
+}
@@ -0,0 +1,692 @@
+/*
+================================================================================
+PELAJARAN 29: WORKER POOL, RATE LIMITER, DAN BACKPRESSURE
+================================================================================
+
+Pelajaran 13 membuat KurangiStok aman dari banyak goroutine, pelajaran 14
+memperkenalkan channel, dan pelajaran 22 memperkenalkan context. Di sini
+semuanya digabung menjadi pola nyata: sekumpulan WORKER memproses aliran
+PESANAN terhadap katalog products dari pelajaran 09.
+
+ALUR PESANAN
+------------
+    producer ──► [ antrean (buffered channel, kapasitas N) ] ──► worker 1 ─┐
+                                                             ──► worker 2 ─┼─► Metrics
+                                                             ──► worker 3 ─┘
+                        rate limiter (token bucket) dipakai bersama semua worker
+
+KOMPONEN
+--------
+┌──────────────────┬──────────────────────────────────────────────────────────┐
+│ Antrean terbatas │ make(chan Order, N): jika penuh, producer IKUT MENUNGGU  │
+│ Backpressure     │ Producer melambat mengikuti kecepatan worker             │
+│ Load shedding    │ TrySubmit: tolak pesanan jika antrean penuh (select)     │
+│ Group            │ Versi sederhana errgroup: error pertama membatalkan ctx  │
+│ TokenBucket      │ Maksimal rate pesanan/detik, boleh "burst" sesaat        │
+│ Metrics          │ Jumlah sukses/gagal dan persentil latensi (p50/p90/p99)  │
+└──────────────────┴──────────────────────────────────────────────────────────┘
+
+TOKEN BUCKET
+------------
+Ember berisi maksimal `burst` token dan terisi `rate` token per detik.
+Setiap pesanan mengambil satu token; jika ember kosong, tunggu sampai terisi.
+
+    burst = 3, rate = 10/detik (1 token tiap 100ms)
+    t=0ms    ●●● → ambil 3 pesanan langsung
+    t=100ms  ●   → pesanan ke-4
+    t=200ms  ●   → pesanan ke-5
+
+ERROR BISNIS vs ERROR FATAL
+---------------------------
+Stok habis adalah kegagalan SATU pesanan: dicatat di Metrics, pool jalan terus.
+Database mati adalah kegagalan SEMUA pesanan: Group membatalkan context agar
+producer dan worker lain berhenti, dan Wait() mengembalikan error pertama.
+
+CATATAN
+-------
+Output berisi durasi sehingga sedikit berbeda setiap dijalankan (tidak ada
+expected_output.txt). Jalankan dengan race detector:
+    go run -race main.go
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// =============================================================================
+// PRODUCT DARI PELAJARAN 09 (AMAN UNTUK GOROUTINE SEPERTI PELAJARAN 13)
+// =============================================================================
+
+// ErrStokHabis dikembalikan jika stok tidak mencukupi pesanan
+var ErrStokHabis = errors.New("stok tidak mencukupi")
+
+// ErrProdukTidakAda dikembalikan jika kode produk tidak ada di katalog
+var ErrProdukTidakAda = errors.New("produk tidak ada")
+
+// Product adalah barang dagangan; mu melindungi Stok dan Tersedia
+type Product struct {
+	Nama     string
+	Harga    float64
+	Stok     int
+	Tersedia bool
+
+	mu sync.Mutex
+}
+
+// KurangiStok mengurangi stok secara atomik (cek dan ubah dalam satu lock)
+func (prod *Product) KurangiStok(jumlah int) error {
+	prod.mu.Lock()
+	defer prod.mu.Unlock()
+	if jumlah > prod.Stok {
+		return ErrStokHabis
+	}
+	prod.Stok -= jumlah
+	if prod.Stok == 0 {
+		prod.Tersedia = false
+	}
+	return nil
+}
+
+// SisaStok membaca stok di bawah lock
+func (prod *Product) SisaStok() int {
+	prod.mu.Lock()
+	defer prod.mu.Unlock()
+	return prod.Stok
+}
+
+// katalog membuat products dari pelajaran 09. Pointer dipakai agar
+// KurangiStok mengubah data di map, bukan salinannya.
+func katalog() map[string]*Product {
+	return map[string]*Product{
+		"P001": {Nama: "Laptop Gaming", Harga: 15000000, Stok: 10, Tersedia: true},
+		"P002": {Nama: "Mouse Wireless", Harga: 250000, Stok: 50, Tersedia: true},
+		"P003": {Nama: "Headset", Harga: 500000, Stok: 0, Tersedia: false},
+	}
+}
+
+// =============================================================================
+// ORDER
+// =============================================================================
+
+// Order adalah satu pesanan pembelian
+type Order struct {
+	ID       int
+	SKU      string
+	Jumlah   int
+	Diterima time.Time // Waktu masuk antrean, untuk menghitung latensi
+}
+
+// OrderError membungkus error beserta ID pesanan yang gagal
+type OrderError struct {
+	OrderID int
+	Err     error
+}
+
+func (e *OrderError) Error() string {
+	return fmt.Sprintf("pesanan #%d: %v", e.OrderID, e.Err)
+}
+
+func (e *OrderError) Unwrap() error {
+	return e.Err
+}
+
+// buatPesanan membuat n pesanan yang bisa diulang (tanpa angka acak):
+// kebanyakan Mouse, sebagian Laptop, sesekali Headset (stok 0) dan P999
+func buatPesanan(n int) []Order {
+	orders := make([]Order, 0, n)
+	for i := 1; i <= n; i++ {
+		o := Order{ID: i, SKU: "P002", Jumlah: 1 + i%3}
+		switch {
+		case i%10 == 0:
+			o.SKU, o.Jumlah = "P003", 1
+		case i%13 == 0:
+			o.SKU = "P999"
+		case i%3 == 0:
+			o.SKU, o.Jumlah = "P001", 1
+		}
+		orders = append(orders, o)
+	}
+	return orders
+}
+
+// =============================================================================
+// GROUP: errgroup BUATAN SENDIRI
+// =============================================================================
+
+// Group menjalankan sekumpulan goroutine dan mengumpulkan error PERTAMA.
+// Meniru golang.org/x/sync/errgroup: saat satu goroutine gagal, context
+// yang dibuat WithContext dibatalkan dengan error itu sebagai cause.
+type Group struct {
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	sem    chan struct{} // nil = tanpa batas jumlah goroutine
+
+	errOnce sync.Once
+	err     error
+}
+
+// WithContext membuat Group dan context turunan yang batal saat ada error
+func WithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit membatasi jumlah goroutine aktif. Go akan MENUNGGU jika batas
+// tercapai: ini juga bentuk backpressure. Panggil sebelum Go pertama.
+func (g *Group) SetLimit(n int) {
+	g.sem = make(chan struct{}, n)
+}
+
+// Go menjalankan f di goroutine baru
+func (g *Group) Go(f func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		if err := f(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel(err)
+				}
+			})
+		}
+	}()
+}
+
+// Wait menunggu semua goroutine selesai lalu mengembalikan error pertama
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err) // Lepaskan resource context, seperti defer cancel()
+	}
+	return g.err
+}
+
+// =============================================================================
+// TOKEN BUCKET RATE LIMITER
+// =============================================================================
+
+// TokenBucket membatasi laju menjadi rate token per detik dengan burst
+// maksimal. Aman dipakai bersama oleh banyak worker.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // token per detik
+	burst  float64 // kapasitas ember
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket membuat limiter dengan ember yang sudah penuh
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	return &TokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// isi menambah token sesuai waktu yang berlalu; harus dipanggil di bawah lock
+func (tb *TokenBucket) isi(now time.Time) {
+	tb.tokens = min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	tb.last = now
+}
+
+// Allow mengambil token jika ada, tanpa menunggu
+func (tb *TokenBucket) Allow() bool {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	tb.isi(time.Now())
+	if tb.tokens < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// Wait menunggu sampai token tersedia atau ctx dibatalkan
+func (tb *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		tb.mu.Lock()
+		tb.isi(time.Now())
+		if tb.tokens >= 1 {
+			tb.tokens--
+			tb.mu.Unlock()
+			return nil
+		}
+		// Waktu sampai token berikutnya penuh. Worker lain bisa mendahului,
+		// karena itu kita mengulang cek setelah bangun.
+		tunggu := time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+		tb.mu.Unlock()
+
+		timer := time.NewTimer(tunggu)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return context.Cause(ctx)
+		case <-timer.C:
+		}
+	}
+}
+
+// =============================================================================
+// METRICS
+// =============================================================================
+
+// Metrics mengumpulkan hasil pemrosesan dari banyak worker
+type Metrics struct {
+	mu        sync.Mutex
+	processed int
+	failed    map[string]int // alasan gagal → jumlah
+	latencies []time.Duration
+}
+
+// NewMetrics membuat Metrics kosong
+func NewMetrics() *Metrics {
+	return &Metrics{failed: make(map[string]int)}
+}
+
+// Record mencatat satu pesanan yang selesai, sukses (err == nil) atau gagal
+func (m *Metrics) Record(latency time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies = append(m.latencies, latency)
+	switch {
+	case err == nil:
+		m.processed++
+	case errors.Is(err, ErrStokHabis):
+		m.failed["stok habis"]++
+	case errors.Is(err, ErrProdukTidakAda):
+		m.failed["produk tidak ada"]++
+	default:
+		m.failed["lainnya"]++
+	}
+}
+
+// Percentile mengembalikan latensi persentil p (0-100) dengan metode
+// nearest-rank: nilai ke-ceil(p/100 × n) dari data yang sudah diurutkan
+func (m *Metrics) Percentile(p float64) time.Duration {
+	m.mu.Lock()
+	sorted := slices.Clone(m.latencies)
+	m.mu.Unlock()
+	if len(sorted) == 0 {
+		return 0
+	}
+	slices.Sort(sorted)
+	rank := int(p / 100 * float64(len(sorted)))
+	if float64(rank) < p/100*float64(len(sorted)) {
+		rank++ // ceil
+	}
+	return sorted[max(rank, 1)-1]
+}
+
+// Print mencetak ringkasan metrics
+func (m *Metrics) Print(durasi time.Duration) {
+	m.mu.Lock()
+	processed, total := m.processed, len(m.latencies)
+	failed := maps.Clone(m.failed)
+	m.mu.Unlock()
+
+	fmt.Printf("  Diproses   : %d\n", processed)
+	fmt.Printf("  Gagal      : %d\n", total-processed)
+	for _, alasan := range slices.Sorted(maps.Keys(failed)) {
+		fmt.Printf("    - %-17s %d\n", alasan, failed[alasan])
+	}
+	fmt.Printf("  Throughput : %.0f pesanan/detik\n", float64(total)/durasi.Seconds())
+	for _, p := range []float64{50, 90, 99} {
+		fmt.Printf("  Latensi p%-2.0f: %v\n", p, m.Percentile(p).Round(100*time.Microsecond))
+	}
+}
+
+// =============================================================================
+// WORKER POOL
+// =============================================================================
+
+// ErrAntreanPenuh dikembalikan TrySubmit jika antrean sedang penuh
+var ErrAntreanPenuh = errors.New("antrean penuh")
+
+// Pool memproses Order dari antrean terbatas dengan sejumlah worker tetap
+type Pool struct {
+	queue    chan Order
+	products map[string]*Product
+	limiter  *TokenBucket // nil = tanpa rate limit
+	metrics  *Metrics
+	kerja    time.Duration // simulasi waktu proses satu pesanan
+	terjual  atomic.Int64  // total unit yang berhasil dijual
+
+	// fatal, jika tidak nil, dipanggil untuk setiap pesanan; error yang
+	// dikembalikan dianggap fatal dan menghentikan seluruh pool
+	fatal func(Order) error
+}
+
+// NewPool membuat Pool dengan antrean berkapasitas queueSize
+func NewPool(products map[string]*Product, queueSize int, limiter *TokenBucket) *Pool {
+	return &Pool{
+		queue:    make(chan Order, queueSize),
+		products: products,
+		limiter:  limiter,
+		metrics:  NewMetrics(),
+		kerja:    2 * time.Millisecond,
+	}
+}
+
+// Submit memasukkan pesanan ke antrean. Jika antrean penuh, Submit
+// MENUNGGU (backpressure) sampai ada tempat atau ctx dibatalkan.
+func (p *Pool) Submit(ctx context.Context, o Order) error {
+	o.Diterima = time.Now()
+	select {
+	case p.queue <- o:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// TrySubmit memasukkan pesanan tanpa menunggu; gagal jika antrean penuh
+func (p *Pool) TrySubmit(o Order) error {
+	o.Diterima = time.Now()
+	select {
+	case p.queue <- o:
+		return nil
+	default:
+		return ErrAntreanPenuh
+	}
+}
+
+// Close menandai tidak ada pesanan baru; worker berhenti setelah antrean kosong
+func (p *Pool) Close() {
+	close(p.queue)
+}
+
+// Start menjalankan n worker di dalam Group g
+func (p *Pool) Start(ctx context.Context, g *Group, n int) {
+	for range n {
+		g.Go(func() error { return p.worker(ctx) })
+	}
+}
+
+// worker mengambil pesanan sampai antrean ditutup atau ctx dibatalkan.
+// Error bisnis dicatat ke metrics; hanya error fatal yang dikembalikan.
+func (p *Pool) worker(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil // Group sudah menyimpan penyebabnya
+		case o, ok := <-p.queue:
+			if !ok {
+				return nil
+			}
+			err := p.proses(ctx, o)
+			if isFatal(err) {
+				return err
+			}
+			p.metrics.Record(time.Since(o.Diterima), err)
+		}
+	}
+}
+
+// proses menjalankan satu pesanan: rate limit, simulasi kerja, kurangi stok
+func (p *Pool) proses(ctx context.Context, o Order) error {
+	if p.limiter != nil {
+		if err := p.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if p.fatal != nil {
+		if err := p.fatal(o); err != nil {
+			return err
+		}
+	}
+	time.Sleep(p.kerja)
+
+	prod, ok := p.products[o.SKU]
+	if !ok {
+		return &OrderError{OrderID: o.ID, Err: fmt.Errorf("%s: %w", o.SKU, ErrProdukTidakAda)}
+	}
+	if err := prod.KurangiStok(o.Jumlah); err != nil {
+		return &OrderError{OrderID: o.ID, Err: fmt.Errorf("%s x%d: %w", o.SKU, o.Jumlah, err)}
+	}
+	p.terjual.Add(int64(o.Jumlah))
+	return nil
+}
+
+// isFatal membedakan error yang menghentikan pool dari error satu pesanan
+func isFatal(err error) bool {
+	return err != nil && !errors.Is(err, ErrStokHabis) && !errors.Is(err, ErrProdukTidakAda)
+}
+
+// Run menjalankan pipeline lengkap: producer mengirim semua pesanan
+// (menunggu jika antrean penuh), lalu menunggu semua worker selesai
+func (p *Pool) Run(ctx context.Context, workers int, orders []Order) error {
+	g, ctx := WithContext(ctx)
+	p.Start(ctx, g, workers)
+
+	g.Go(func() error {
+		defer p.Close()
+		for _, o := range orders {
+			if err := p.Submit(ctx, o); err != nil {
+				return nil // Dibatalkan karena error lain; penyebabnya sudah dicatat
+			}
+		}
+		return nil
+	})
+	return g.Wait()
+}
+
+// =============================================================================
+// MAIN FUNCTION
+// =============================================================================
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("WORKER POOL, RATE LIMITER, DAN BACKPRESSURE")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	ctx := context.Background()
+
+	// =============================================================================
+	// 1. ANTREAN TERBATAS DAN BACKPRESSURE
+	// =============================================================================
+	fmt.Println("--- 1. Antrean Terbatas dan Backpressure ---")
+
+	// Satu worker lambat (20ms), antrean hanya 2: producer ikut tertahan
+	lambat := NewPool(katalog(), 2, nil)
+	lambat.kerja = 20 * time.Millisecond
+	g, gctx := WithContext(ctx)
+	lambat.Start(gctx, g, 1)
+	mulai := time.Now()
+	for _, o := range buatPesanan(6) {
+		sebelum := time.Now()
+		if err := lambat.Submit(gctx, o); err != nil {
+			fmt.Println("submit:", err)
+		}
+		fmt.Printf("  submit #%d tertahan %3dms (isi antrean %d/%d)\n",
+			o.ID, time.Since(sebelum).Round(10*time.Millisecond).Milliseconds(), len(lambat.queue), cap(lambat.queue))
+	}
+	lambat.Close()
+	if err := g.Wait(); err != nil {
+		fmt.Println("error:", err)
+	}
+	fmt.Printf("Total %v: producer berjalan secepat worker, memory antrean tetap kecil\n",
+		time.Since(mulai).Round(10*time.Millisecond))
+
+	// =============================================================================
+	// 2. LOAD SHEDDING DENGAN TrySubmit
+	// =============================================================================
+	fmt.Println("\n--- 2. Load Shedding: Tolak Jika Antrean Penuh ---")
+
+	// Tanpa worker: antrean berkapasitas 3 langsung penuh
+	shed := NewPool(katalog(), 3, nil)
+	var diterima, ditolak int
+	for _, o := range buatPesanan(5) {
+		if err := shed.TrySubmit(o); errors.Is(err, ErrAntreanPenuh) {
+			ditolak++
+			fmt.Printf("  #%d ditolak: %v (balas HTTP 503, minta klien coba lagi)\n", o.ID, err)
+			continue
+		}
+		diterima++
+	}
+	fmt.Printf("Diterima %d, ditolak %d\n", diterima, ditolak)
+
+	// =============================================================================
+	// 3. TOKEN BUCKET RATE LIMITER
+	// =============================================================================
+	fmt.Println("\n--- 3. Token Bucket: burst 3, 20 token/detik ---")
+
+	limiter := NewTokenBucket(20, 3)
+	mulai = time.Now()
+	for i := 1; i <= 6; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			fmt.Println("error:", err)
+		}
+		fmt.Printf("  pesanan %d diizinkan pada t=%3dms\n", i, time.Since(mulai).Round(10*time.Millisecond).Milliseconds())
+	}
+	fmt.Println("Allow() saat ember kosong:", limiter.Allow())
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	lambatLimiter := NewTokenBucket(1, 1)
+	lambatLimiter.Allow() // Kosongkan ember; token berikutnya 1 detik lagi
+	err := lambatLimiter.Wait(timeoutCtx)
+	cancel()
+	fmt.Println("Wait dengan timeout 10ms:", err)
+
+	// =============================================================================
+	// 4. GROUP: ERROR PERTAMA MEMBATALKAN SEMUANYA
+	// =============================================================================
+	fmt.Println("\n--- 4. Group: Propagasi Error ala errgroup ---")
+
+	g, gctx = WithContext(ctx)
+	var dibatalkan atomic.Int32
+	for i := 1; i <= 4; i++ {
+		g.Go(func() error {
+			if i == 2 {
+				time.Sleep(5 * time.Millisecond)
+				return fmt.Errorf("tugas %d: koneksi database terputus", i)
+			}
+			select {
+			case <-time.After(time.Second):
+				return nil
+			case <-gctx.Done():
+				dibatalkan.Add(1)
+				return gctx.Err()
+			}
+		})
+	}
+	err = g.Wait()
+	fmt.Println("Wait()               :", err)
+	fmt.Println("context.Cause(gctx)  :", context.Cause(gctx))
+	fmt.Println("Tugas lain dibatalkan:", dibatalkan.Load(), "(tidak menunggu 1 detik)")
+
+	// SetLimit: maksimal 2 goroutine aktif bersamaan
+	g, _ = WithContext(ctx)
+	g.SetLimit(2)
+	var aktif, puncak atomic.Int32
+	for range 6 {
+		g.Go(func() error {
+			n := aktif.Add(1)
+			for {
+				p := puncak.Load()
+				if n <= p || puncak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			aktif.Add(-1)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		fmt.Println("error:", err)
+	}
+	fmt.Println("SetLimit(2), 6 tugas → puncak goroutine aktif:", puncak.Load())
+
+	// =============================================================================
+	// 5. PIPELINE LENGKAP DENGAN METRICS
+	// =============================================================================
+	fmt.Println("\n--- 5. Pipeline Lengkap: 40 Pesanan, 4 Worker, Antrean 8 ---")
+
+	products := katalog()
+	awal := map[string]int{}
+	for sku, p := range products {
+		awal[sku] = p.SisaStok()
+	}
+	orders := buatPesanan(40)
+
+	pool := NewPool(products, 8, NewTokenBucket(500, 10))
+	mulai = time.Now()
+	if err := pool.Run(ctx, 4, orders); err != nil {
+		fmt.Println("error:", err)
+	}
+	durasi := time.Since(mulai)
+	fmt.Printf("Selesai dalam %v\n", durasi.Round(time.Millisecond))
+	pool.metrics.Print(durasi)
+
+	// Cek konsistensi: unit terjual harus sama dengan stok yang berkurang.
+	// Tanpa lock di KurangiStok, dua worker bisa menjual unit yang sama.
+	fmt.Println("Stok akhir:")
+	berkurang := 0
+	for _, sku := range slices.Sorted(maps.Keys(products)) {
+		p := products[sku]
+		fmt.Printf("  %s %-15s %2d → %2d  tersedia=%t\n", sku, p.Nama, awal[sku], p.SisaStok(), p.Tersedia)
+		berkurang += awal[sku] - p.SisaStok()
+	}
+	status := "KONSISTEN"
+	if int64(berkurang) != pool.terjual.Load() {
+		status = "TIDAK KONSISTEN"
+	}
+	fmt.Printf("Unit terjual %d, stok berkurang %d → %s\n", pool.terjual.Load(), berkurang, status)
+
+	// =============================================================================
+	// 6. ERROR FATAL DI TENGAH PIPELINE
+	// =============================================================================
+	fmt.Println("\n--- 6. Error Fatal Menghentikan Pool ---")
+
+	fatalPool := NewPool(katalog(), 4, nil)
+	var dicoba atomic.Int32
+	fatalPool.fatal = func(o Order) error {
+		dicoba.Add(1)
+		if o.ID == 15 {
+			return &OrderError{OrderID: o.ID, Err: errors.New("database tidak bisa dihubungi")}
+		}
+		return nil
+	}
+	err = fatalPool.Run(ctx, 3, buatPesanan(1000))
+	var oe *OrderError
+	fmt.Println("Run()        :", err)
+	fmt.Println("OrderError?  :", errors.As(err, &oe), "ID =", oe.OrderID)
+	fmt.Printf("Diproses     : %d dari 1000 (producer dan worker berhenti lebih awal)\n", dicoba.Load())
+
+	// =============================================================================
+	// 7. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 7. Best Practices Worker Pool ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Batasi antrean (buffered channel) agar memory tidak tumbuh tanpa batas")
+	fmt.Println("   - Pilih sadar: tunggu (backpressure) atau tolak (load shedding)")
+	fmt.Println("   - Bedakan error satu pesanan dari error yang menghentikan semuanya")
+	fmt.Println("   - Hanya producer yang menutup channel antrean")
+	fmt.Println("   - Ukur persentil latensi, bukan hanya rata-rata")
+	fmt.Println("   - Di proyek nyata: golang.org/x/sync/errgroup dan golang.org/x/time/rate")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Membuat satu goroutine per pesanan tanpa batas")
+	fmt.Println("   - Mengabaikan ctx.Done() saat menunggu antrean atau rate limiter")
+	fmt.Println("   - Memakai time.Sleep tetap sebagai pengganti rate limiter")
+	fmt.Println("   - Lupa menjalankan go run -race main.go")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Worker pool: antrean terbatas, error terkendali, laju terukur")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,948 @@
+/*
+================================================================================
+PELAJARAN 30: PROFILING DENGAN pprof
+================================================================================
+
+Pelajaran 10 menyarankan pointer untuk struct besar ("hemat memory copy") dan
+pelajaran 06 memakai append tanpa make(..., 0, n), tetapi belum ada yang
+MENGUKUR. Profiler menjawab pertanyaan "waktu dan memory habis di mana?"
+dengan data, bukan tebakan.
+
+JENIS PROFILE
+-------------
+┌───────────┬────────────────────────────────────────────────────────────────┐
+│ cpu       │ Fungsi mana yang sedang berjalan (sampel 100 kali per detik)   │
+│ allocs    │ Semua alokasi sejak program mulai (jumlah objek dan byte)      │
+│ heap      │ Memory yang MASIH dipakai saat profile ditulis                 │
+│ goroutine │ Stack semua goroutine (mencari goroutine yang bocor)           │
+│ block     │ Waktu menunggu channel/select (perlu SetBlockProfileRate)      │
+│ mutex     │ Waktu berebut lock (perlu SetMutexProfileFraction)             │
+└───────────┴────────────────────────────────────────────────────────────────┘
+
+TIGA CARA MEMBUAT PROFILE
+-------------------------
+1. Dari program (runtime/pprof) — dipakai pelajaran ini:
+       f, _ := os.Create("cpu.prof")
+       pprof.StartCPUProfile(f)
+       defer pprof.StopCPUProfile()
+2. Dari benchmark (testing), misal benchmark Factorial di pelajaran 16:
+       go test ./16_testing/calc -run '^$' -bench Factorial \
+           -cpuprofile cpu.prof -memprofile mem.prof
+3. Dari server yang berjalan: import _ "net/http/pprof" lalu buka
+       http://localhost:8080/debug/pprof/
+
+MEMBACA HASIL
+-------------
+    go tool pprof -top cpu.prof      ← alat resmi
+    go run main.go top cpu.prof      ← parser buatan pelajaran ini
+
+    flat  = waktu/memory di fungsi itu SENDIRI
+    cum   = flat + semua fungsi yang dipanggilnya (kumulatif)
+    sum%  = total flat% baris ini dan baris-baris di atasnya
+
+Nilai profile adalah hasil SAMPLING. 200ms berarti ±20 sample CPU, dan
+jumlah sample wajar meleset ±√n. Selisih 260ms vs 230ms (26 vs 23 sample)
+masih noise; bagian 4 menghitung batas ini sebelum menarik kesimpulan.
+
+FORMAT FILE .prof
+-----------------
+File profile adalah protobuf (profile.proto) yang di-gzip. Pelajaran ini
+membacanya hanya dengan library standar (compress/gzip + encoding/binary):
+┌────┬──────────────┬─────────────────────────────────────────────────────┐
+│ No │ Field        │ Isi                                                 │
+├────┼──────────────┼─────────────────────────────────────────────────────┤
+│  1 │ sample_type  │ Jenis nilai: cpu/nanoseconds, alloc_space/bytes     │
+│  2 │ sample       │ location_id[] (stack) dan value[]                   │
+│  4 │ location     │ id dan line[] → function_id                         │
+│  5 │ function     │ id dan nama (indeks ke string_table)                │
+│  6 │ string_table │ Semua string; indeks 0 selalu ""                    │
+│ 12 │ period       │ Jarak antar sample: 10ms (cpu), MemProfileRate byte │
+└────┴──────────────┴─────────────────────────────────────────────────────┘
+
+MODE PROGRAM
+------------
+    go run main.go              profile CPU + allocs, lalu laporan top-N
+    go run main.go top FILE [N] laporan top-N dari file .prof mana pun
+
+Repository ini tidak punya launcher terpisah: laporan top-N dijalankan dari
+program pelajaran ini sendiri, misal go run ./30_profiling top cpu.prof 5.
+
+Angka per operasi untuk setiap pasangan ada di benchmark profiling_test.go:
+    go test -bench . -benchmem ./30_profiling
+
+File cpu.prof dan allocs.prof ditulis ke direktori kerja (diabaikan
+.gitignore). Angka berbeda di setiap mesin, jadi tidak ada expected_output.txt.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// BEBAN KERJA 1: FACTORIAL (PELAJARAN 08)
+// =============================================================================
+
+// factorial versi rekursif dari pelajaran 08. Tanpa go:noinline compiler
+// menyisipkan beberapa level rekursi sekaligus sehingga biaya call tersamar.
+//
+//go:noinline
+func factorial(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * factorial(n-1)
+}
+
+// factorialIteratif menghasilkan nilai yang sama tanpa pemanggilan berulang
+func factorialIteratif(n int) int {
+	hasil := 1
+	for i := 2; i <= n; i++ {
+		hasil *= i
+	}
+	return hasil
+}
+
+// =============================================================================
+// BEBAN KERJA 2: APPEND (PELAJARAN 06)
+// =============================================================================
+
+// kumpulkanTanpaPrealokasi memakai append seperti pelajaran 06: setiap kali
+// kapasitas habis, Go mengalokasikan array baru dan menyalin isi lama
+func kumpulkanTanpaPrealokasi(n int) []int {
+	var s []int
+	for i := range n {
+		s = append(s, i*i)
+	}
+	return s
+}
+
+// kumpulkanDenganPrealokasi mengalokasikan sekali karena n sudah diketahui
+func kumpulkanDenganPrealokasi(n int) []int {
+	s := make([]int, 0, n)
+	for i := range n {
+		s = append(s, i*i)
+	}
+	return s
+}
+
+// =============================================================================
+// BEBAN KERJA 3: VALUE VS POINTER (PELAJARAN 09 DAN 10)
+// =============================================================================
+
+// Person dari pelajaran 09: kecil (string + int + string = 40 byte)
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+}
+
+// PersonBesar seperti BigStruct pelajaran 10: sekitar 8 KB
+type PersonBesar struct {
+	Person
+	Riwayat [1000]int
+}
+
+// go:noinline mencegah compiler menyisipkan isi fungsi ke pemanggil,
+// supaya biaya memanggil fungsi benar-benar terukur.
+
+//go:noinline
+func umurValue(p Person) int {
+	return p.Umur
+}
+
+//go:noinline
+func umurPointer(p *Person) int {
+	return p.Umur
+}
+
+//go:noinline
+func umurBesarValue(p PersonBesar) int {
+	return p.Umur + p.Riwayat[len(p.Riwayat)-1]
+}
+
+//go:noinline
+func umurBesarPointer(p *PersonBesar) int {
+	return p.Umur + p.Riwayat[len(p.Riwayat)-1]
+}
+
+// buatPerson mengembalikan value: hasil disalin ke stack pemanggil
+//
+//go:noinline
+func buatPerson(nama string, umur int) Person {
+	return Person{Nama: nama, Umur: umur, Alamat: "Jakarta"}
+}
+
+// buatPersonPointer mengembalikan pointer: Person "kabur" (escape) ke heap
+// karena masih dipakai setelah fungsi selesai. Cek dengan:
+//
+//	go build -gcflags=-m main.go
+//	./main.go: &Person{...} escapes to heap
+//
+//go:noinline
+func buatPersonPointer(nama string, umur int) *Person {
+	return &Person{Nama: nama, Umur: umur, Alamat: "Jakarta"}
+}
+
+// sink dan sinkInt menampung hasil agar compiler tidak menghapus perhitungan.
+// sinkInt dipakai untuk int: menyimpan int ke any butuh alokasi sendiri.
+var (
+	sink    any
+	sinkInt int
+)
+
+// =============================================================================
+// MENJALANKAN BEBAN KERJA
+// =============================================================================
+// Setiap fungsi ulang* memanggil satu beban kerja berulang kali. Biayanya,
+// termasuk menyalin argumen, tercatat atas nama fungsi itu di profile
+// sehingga pasangan yang dibandingkan mudah dibaca dari kolom cum.
+
+//go:noinline
+func ulangFactorial(n int) (total int) {
+	for range n {
+		total += factorial(20)
+	}
+	return total
+}
+
+//go:noinline
+func ulangFactorialIteratif(n int) (total int) {
+	for range n {
+		total += factorialIteratif(20)
+	}
+	return total
+}
+
+//go:noinline
+func ulangTanpaPrealokasi(n int) {
+	for range n {
+		sink = kumpulkanTanpaPrealokasi(50_000)
+	}
+}
+
+//go:noinline
+func ulangDenganPrealokasi(n int) {
+	for range n {
+		sink = kumpulkanDenganPrealokasi(50_000)
+	}
+}
+
+//go:noinline
+func ulangBesarValue(p *PersonBesar, n int) (total int) {
+	for range n {
+		total += umurBesarValue(*p) // Menyalin 8 KB setiap panggilan
+	}
+	return total
+}
+
+//go:noinline
+func ulangBesarPointer(p *PersonBesar, n int) (total int) {
+	for range n {
+		total += umurBesarPointer(p)
+	}
+	return total
+}
+
+//go:noinline
+func ulangValue(p Person, n int) (total int) {
+	for range n {
+		total += umurValue(p)
+	}
+	return total
+}
+
+//go:noinline
+func ulangPointer(p *Person, n int) (total int) {
+	for range n {
+		total += umurPointer(p)
+	}
+	return total
+}
+
+//go:noinline
+func ulangBuatPerson(n int) (total int) {
+	for i := range n {
+		total += buatPerson("Ani", i).Umur
+	}
+	return total
+}
+
+//go:noinline
+func ulangBuatPersonPointer(n int) {
+	for i := range n {
+		sink = buatPersonPointer("Ani", i)
+	}
+}
+
+// bebanKerja menjalankan semua pasangan yang akan dibandingkan
+func bebanKerja() {
+	kecil := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+	besar := &PersonBesar{Person: kecil}
+
+	sinkInt = ulangFactorial(20_000_000)
+	sinkInt = ulangFactorialIteratif(20_000_000)
+	ulangTanpaPrealokasi(500)
+	ulangDenganPrealokasi(500)
+	sinkInt = ulangBesarValue(besar, 500_000)
+	sinkInt = ulangBesarPointer(besar, 500_000)
+	sinkInt = ulangValue(kecil, 100_000_000)
+	sinkInt = ulangPointer(&kecil, 100_000_000)
+	sinkInt = ulangBuatPerson(10_000_000)
+	ulangBuatPersonPointer(10_000_000)
+}
+
+// =============================================================================
+// PARSER PROTOBUF MINIMAL
+// =============================================================================
+
+var (
+	errProtobuf = errors.New("protobuf rusak")
+	errProfile  = errors.New("profile tidak valid")
+)
+
+// Tipe wire protobuf
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// pbFields memanggil fn untuk setiap field di pesan protobuf data.
+// Nilai angka ada di v; isi length-delimited (string, pesan, packed) di b.
+func pbFields(data []byte, fn func(num int, wire uint64, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errProtobuf
+		}
+		data = data[n:]
+		num, wire := int(key>>3), key&7
+
+		var v uint64
+		var b []byte
+		switch wire {
+		case wireVarint:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errProtobuf
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return errProtobuf
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return errProtobuf
+			}
+			b, data = data[n:n+int(l)], data[n+int(l):]
+		case wireFixed32:
+			if len(data) < 4 {
+				return errProtobuf
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		default:
+			return fmt.Errorf("wire type %d: %w", wire, errProtobuf)
+		}
+		if err := fn(num, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pbVarints menambahkan repeated integer ke dst. Protobuf boleh menulisnya
+// "packed" (semua angka dalam satu field bytes) atau satu angka per field.
+func pbVarints(dst []uint64, wire uint64, v uint64, b []byte) ([]uint64, error) {
+	if wire == wireVarint {
+		return append(dst, v), nil
+	}
+	for len(b) > 0 {
+		x, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, errProtobuf
+		}
+		dst, b = append(dst, x), b[n:]
+	}
+	return dst, nil
+}
+
+// =============================================================================
+// PROFILE
+// =============================================================================
+
+// ValueType adalah jenis dan satuan satu kolom nilai, misal cpu/nanoseconds
+type ValueType struct {
+	Type, Unit string
+}
+
+// Sample adalah satu stack beserta nilainya; Stack[0] fungsi paling dalam
+type Sample struct {
+	Stack []string
+	Value []int64
+}
+
+// Profile adalah isi file .prof yang sudah diterjemahkan
+type Profile struct {
+	SampleType []ValueType
+	Sample     []Sample
+	Period     int64 // Jarak antar sample: 10ms untuk cpu, MemProfileRate byte untuk allocs
+}
+
+// ParseProfile membaca profile pprof (gzip atau tidak) dari r
+func ParseProfile(r io.Reader) (*Profile, error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	} else {
+		r = br
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index ke string_table baru bisa diterjemahkan setelah seluruh pesan
+	// dibaca, karena string_table boleh muncul di mana saja.
+	var (
+		strs      []string
+		types     [][2]uint64             // indeks string type dan unit
+		samples   [][2][]uint64           // location_id[] dan value[]
+		locations = map[uint64][]uint64{} // location id → function id[]
+		functions = map[uint64]uint64{}   // function id → indeks nama
+		period    int64
+	)
+
+	err = pbFields(data, func(num int, wire, v uint64, b []byte) error {
+		switch num {
+		case 1: // sample_type
+			var vt [2]uint64
+			err := pbFields(b, func(num int, _, v uint64, _ []byte) error {
+				if num == 1 || num == 2 {
+					vt[num-1] = v
+				}
+				return nil
+			})
+			types = append(types, vt)
+			return err
+		case 2: // sample
+			var s [2][]uint64
+			err := pbFields(b, func(num int, wire, v uint64, b []byte) error {
+				var err error
+				if num == 1 || num == 2 {
+					s[num-1], err = pbVarints(s[num-1], wire, v, b)
+				}
+				return err
+			})
+			samples = append(samples, s)
+			return err
+		case 4: // location
+			var id uint64
+			var fns []uint64
+			err := pbFields(b, func(num int, _, v uint64, b []byte) error {
+				switch num {
+				case 1:
+					id = v
+				case 4: // line: field 1 adalah function_id
+					return pbFields(b, func(num int, _, v uint64, _ []byte) error {
+						if num == 1 {
+							fns = append(fns, v)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			locations[id] = fns
+			return err
+		case 5: // function
+			var id, nama uint64
+			err := pbFields(b, func(num int, _, v uint64, _ []byte) error {
+				switch num {
+				case 1:
+					id = v
+				case 2:
+					nama = v
+				}
+				return nil
+			})
+			functions[id] = nama
+			return err
+		case 6: // string_table
+			strs = append(strs, string(b))
+		case 12: // period
+			period = int64(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	str := func(i uint64) string {
+		if i < uint64(len(strs)) {
+			return strs[i]
+		}
+		return "?"
+	}
+	// Setiap sample wajib punya satu nilai per sample_type. Diperiksa di sini
+	// agar Top dan topCommand tidak perlu menebak isi profile.
+	if len(types) == 0 {
+		return nil, fmt.Errorf("tidak ada sample_type: %w", errProfile)
+	}
+	p := &Profile{Period: period}
+	for _, vt := range types {
+		p.SampleType = append(p.SampleType, ValueType{Type: str(vt[0]), Unit: str(vt[1])})
+	}
+	for i, s := range samples {
+		if len(s[1]) != len(types) {
+			return nil, fmt.Errorf("sample %d punya %d nilai, want %d: %w", i, len(s[1]), len(types), errProfile)
+		}
+		sample := Sample{Value: make([]int64, len(s[1]))}
+		for i, v := range s[1] {
+			sample.Value[i] = int64(v)
+		}
+		for _, loc := range s[0] {
+			// Satu location bisa berisi beberapa fungsi karena inlining;
+			// urutannya sudah dari yang paling dalam
+			for _, fn := range locations[loc] {
+				sample.Stack = append(sample.Stack, str(functions[fn]))
+			}
+		}
+		p.Sample = append(p.Sample, sample)
+	}
+	return p, nil
+}
+
+// Index mencari kolom nilai berdasarkan jenisnya, misal "cpu" atau "alloc_space"
+func (p *Profile) Index(tipe string) (int, error) {
+	for i, vt := range p.SampleType {
+		if vt.Type == tipe {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("profile tidak punya nilai %q", tipe)
+}
+
+// Baris adalah satu baris laporan top
+type Baris struct {
+	Fungsi    string
+	Flat, Cum int64
+}
+
+// Top menjumlahkan nilai kolom idx per fungsi, urut flat terbesar.
+// Fungsi rekursif (factorial) muncul berkali-kali dalam satu stack,
+// tetapi cum hanya dihitung SEKALI per sample.
+func (p *Profile) Top(idx int) (rows []Baris, total int64, err error) {
+	if idx < 0 || idx >= len(p.SampleType) {
+		return nil, 0, fmt.Errorf("kolom nilai %d, profile hanya punya %d: %w", idx, len(p.SampleType), errProfile)
+	}
+	byName := map[string]*Baris{}
+	get := func(nama string) *Baris {
+		b, ok := byName[nama]
+		if !ok {
+			b = &Baris{Fungsi: nama}
+			byName[nama] = b
+		}
+		return b
+	}
+	for i, s := range p.Sample {
+		if idx >= len(s.Value) {
+			return nil, 0, fmt.Errorf("sample %d hanya punya %d nilai: %w", i, len(s.Value), errProfile)
+		}
+		v := s.Value[idx]
+		if v == 0 || len(s.Stack) == 0 {
+			continue
+		}
+		total += v
+		get(s.Stack[0]).Flat += v
+		seen := map[string]bool{}
+		for _, fn := range s.Stack {
+			if !seen[fn] {
+				seen[fn] = true
+				get(fn).Cum += v
+			}
+		}
+	}
+	for _, b := range byName {
+		rows = append(rows, *b)
+	}
+	slices.SortFunc(rows, func(a, b Baris) int {
+		return cmp.Or(cmp.Compare(b.Flat, a.Flat), cmp.Compare(b.Cum, a.Cum), strings.Compare(a.Fungsi, b.Fungsi))
+	})
+	return rows, total, nil
+}
+
+// formatNilai menampilkan nilai sesuai satuannya
+func formatNilai(v int64, unit string) string {
+	switch unit {
+	case "nanoseconds":
+		return time.Duration(v).Round(time.Millisecond).String()
+	case "bytes":
+		switch {
+		case v >= 1<<20:
+			return fmt.Sprintf("%.1fMB", float64(v)/(1<<20))
+		case v >= 1<<10:
+			return fmt.Sprintf("%.1fkB", float64(v)/(1<<10))
+		}
+		return fmt.Sprintf("%dB", v)
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+// cetakTop mencetak laporan seperti go tool pprof -top
+func cetakTop(p *Profile, tipe string, n int) error {
+	idx, err := p.Index(tipe)
+	if err != nil {
+		return err
+	}
+	unit := p.SampleType[idx].Unit
+	rows, total, err := p.Top(idx)
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		fmt.Println("  (tidak ada sample)")
+		return nil
+	}
+	persen := func(v int64) float64 { return float64(v) / float64(total) * 100 }
+
+	fmt.Printf("  Total %s: %s, %d fungsi\n", tipe, formatNilai(total, unit), len(rows))
+	fmt.Printf("  %9s %7s %7s %9s %7s  %s\n", "flat", "flat%", "sum%", "cum", "cum%", "fungsi")
+	var sum int64
+	for _, r := range rows[:min(n, len(rows))] {
+		sum += r.Flat
+		fmt.Printf("  %9s %6.2f%% %6.2f%% %9s %6.2f%%  %s\n",
+			formatNilai(r.Flat, unit), persen(r.Flat), persen(sum),
+			formatNilai(r.Cum, unit), persen(r.Cum), r.Fungsi)
+	}
+	return nil
+}
+
+// topTipe mengembalikan baris Top untuk kolom nilai berjenis tipe
+func topTipe(p *Profile, tipe string) ([]Baris, error) {
+	idx, err := p.Index(tipe)
+	if err != nil {
+		return nil, err
+	}
+	rows, _, err := p.Top(idx)
+	return rows, err
+}
+
+// cumFungsi mengembalikan nilai cum satu fungsi (0 jika tidak ada)
+func cumFungsi(rows []Baris, nama string) int64 {
+	for _, r := range rows {
+		if r.Fungsi == nama {
+			return r.Cum
+		}
+	}
+	return 0
+}
+
+// simpulkan menarik kesimpulan dari nilai cum dua fungsi. Profile berbasis
+// sampling: nilai v mewakili sekitar v/period sample, dan jumlah sample
+// wajar meleset ±√n. Selisih yang tidak melebihi dua kali simpangan gabungan
+// dianggap noise sampling. aLebih bernilai true jika a jelas lebih besar.
+func simpulkan(namaA, namaB string, a, b, period int64, unit string) (kesimpulan string, aLebih bool) {
+	period = max(period, 1)
+	na, nb := float64(a)/float64(period), float64(b)/float64(period)
+	if na+nb == 0 {
+		return "keduanya tidak tercatat: terlalu cepat untuk sampling", false
+	}
+	noise := 2 * math.Sqrt(na+nb)
+	selisih := math.Abs(na - nb)
+	if selisih <= noise {
+		return fmt.Sprintf("selisih %.0f sample, dalam noise sampling (±%.0f): tidak bisa disimpulkan berbeda",
+			selisih, noise), false
+	}
+
+	lebih := "lebih besar"
+	switch unit {
+	case "nanoseconds":
+		lebih = "lebih lambat"
+	case "bytes":
+		lebih = "mengalokasi lebih banyak"
+	}
+	besar, kecil, nBesar, nKecil := namaA, namaB, na, nb
+	if nb > na {
+		besar, kecil, nBesar, nKecil = namaB, namaA, nb, na
+	}
+	besar, kecil = strings.TrimPrefix(besar, "main."), strings.TrimPrefix(kecil, "main.")
+	if nKecil == 0 {
+		return fmt.Sprintf("hanya %s tercatat: %s %s (%.0f sample)", besar, besar, lebih, nBesar), na > nb
+	}
+	return fmt.Sprintf("%s %.1fx %s dari %s (selisih %.0f sample > noise ±%.0f)",
+		besar, nBesar/nKecil, lebih, kecil, selisih, noise), na > nb
+}
+
+// bacaProfile membuka dan mem-parse file .prof
+func bacaProfile(nama string) (*Profile, error) {
+	f, err := os.Open(nama)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := ParseProfile(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", nama, err)
+	}
+	return p, nil
+}
+
+// =============================================================================
+// MEMBUAT PROFILE DENGAN runtime/pprof
+// =============================================================================
+
+// profileCPU menjalankan fn sambil merekam CPU profile ke file nama
+func profileCPU(nama string, fn func()) error {
+	f, err := os.Create(nama)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := pprof.StartCPUProfile(f); err != nil {
+		return err
+	}
+	fn()
+	pprof.StopCPUProfile()
+	return f.Close()
+}
+
+// tulisAllocs menulis profile "allocs": semua alokasi sejak program mulai
+func tulisAllocs(nama string) error {
+	f, err := os.Create(nama)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	runtime.GC() // Statistik heap baru lengkap setelah GC
+	if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// =============================================================================
+// MAIN FUNCTION
+// =============================================================================
+
+func main() {
+	// Sampling alokasi: default satu sample per 512 KB. Diperkecil agar
+	// alokasi kecil (Person 40 byte) ikut tercatat. Harus diatur sedini mungkin.
+	runtime.MemProfileRate = 4096
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "top":
+			os.Exit(topCommand(os.Args[2:]))
+		}
+	}
+
+	fmt.Println("================================================================================")
+	fmt.Println("PROFILING DENGAN pprof")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. MEREKAM CPU PROFILE
+	// =============================================================================
+	fmt.Println("--- 1. Merekam CPU Profile (runtime/pprof) ---")
+
+	mulai := time.Now()
+	err := profileCPU("cpu.prof", bebanKerja)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Beban kerja selesai dalam %v → cpu.prof\n", time.Since(mulai).Round(10*time.Millisecond))
+
+	if err := tulisAllocs("allocs.prof"); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	fmt.Println("Profile alokasi → allocs.prof")
+
+	// =============================================================================
+	// 2. LAPORAN TOP CPU
+	// =============================================================================
+	fmt.Println("\n--- 2. Top 10 CPU (setara go tool pprof -top cpu.prof) ---")
+
+	cpu, err := bacaProfile("cpu.prof")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("  Jenis nilai: %v\n", cpu.SampleType)
+	if err := cetakTop(cpu, "cpu", 10); err != nil {
+		fmt.Println("error:", err)
+	}
+
+	// =============================================================================
+	// 3. LAPORAN TOP ALOKASI
+	// =============================================================================
+	fmt.Println("\n--- 3. Top 8 Alokasi (go tool pprof -sample_index=alloc_space -top) ---")
+
+	allocs, err := bacaProfile("allocs.prof")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	if err := cetakTop(allocs, "alloc_space", 8); err != nil {
+		fmt.Println("error:", err)
+	}
+
+	// =============================================================================
+	// 4. MEMBANDINGKAN PASANGAN FUNGSI
+	// =============================================================================
+	fmt.Println("\n--- 4. Perbandingan Dari Data Profile ---")
+
+	cpuRows, err := topTipe(cpu, "cpu")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	allocRows, err := topTipe(allocs, "alloc_space")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	// Kesimpulan dihitung dari angka yang terukur, bukan ditulis di depan:
+	// alasan hanya dicetak jika data memang menunjukkan a lebih besar dari b.
+	pasangan := []struct {
+		judul  string
+		a, b   string
+		rows   []Baris
+		unit   string
+		period int64
+		alasan string
+	}{
+		{"CPU factorial(20) rekursif vs iteratif", "main.ulangFactorial", "main.ulangFactorialIteratif", cpuRows, "nanoseconds", cpu.Period,
+			"setiap panggilan rekursif menambah biaya call + stack"},
+		{"Alokasi append tanpa vs dengan make", "main.ulangTanpaPrealokasi", "main.ulangDenganPrealokasi", allocRows, "bytes", allocs.Period,
+			"tanpa prealokasi, array lama dibuang setiap kapasitas habis"},
+		{"CPU PersonBesar value vs pointer", "main.ulangBesarValue", "main.ulangBesarPointer", cpuRows, "nanoseconds", cpu.Period,
+			"value menyalin 8 KB setiap panggilan"},
+		{"CPU Person kecil value vs pointer", "main.ulangValue", "main.ulangPointer", cpuRows, "nanoseconds", cpu.Period,
+			"menyalin 40 byte ternyata terukur di mesin ini"},
+		{"Alokasi buatPersonPointer vs buatPerson", "main.ulangBuatPersonPointer", "main.ulangBuatPerson", allocRows, "bytes", allocs.Period,
+			"pointer yang dikembalikan escape ke heap; value tetap di stack"},
+	}
+	for _, p := range pasangan {
+		va, vb := cumFungsi(p.rows, p.a), cumFungsi(p.rows, p.b)
+		fmt.Printf("%s:\n", p.judul)
+		fmt.Printf("  %-32s %9s\n", p.a, formatNilai(va, p.unit))
+		fmt.Printf("  %-32s %9s\n", p.b, formatNilai(vb, p.unit))
+		kesimpulan, aLebih := simpulkan(p.a, p.b, va, vb, p.period, p.unit)
+		fmt.Printf("  → %s\n", kesimpulan)
+		if aLebih {
+			fmt.Printf("    sebab: %s\n", p.alasan)
+		}
+	}
+	fmt.Printf("Catatan: satu sample CPU = %v, satu sample alokasi ≈ %s. Fungsi yang\n",
+		time.Duration(cpu.Period), formatNilai(allocs.Period, "bytes"))
+	fmt.Println("sangat cepat bisa tidak tercatat. Untuk angka per operasi: go test -bench . ./30_profiling")
+
+	// =============================================================================
+	// 5. ESCAPE ANALYSIS
+	// =============================================================================
+	fmt.Println("\n--- 5. Escape Analysis: Kenapa Pointer Tidak Selalu Hemat ---")
+
+	fmt.Println("go build -gcflags=-m main.go menampilkan keputusan compiler, misal:")
+	fmt.Println("  &Person{...} escapes to heap        ← buatPersonPointer: alokasi heap + kerja GC")
+	fmt.Println("  p does not escape                    ← umurPointer: pointer tetap di stack")
+	fmt.Println("Value 40 byte disalin lebih murah daripada alokasi heap + garbage collection.")
+	fmt.Println("Pointer baru menang jika struct besar (PersonBesar 8 KB) atau perlu diubah.")
+
+	// =============================================================================
+	// 6. PROFILE DARI go test
+	// =============================================================================
+	fmt.Println("\n--- 6. Profile dari go test ---")
+
+	fmt.Println("Benchmark pelajaran 16 bisa langsung menghasilkan profile:")
+	fmt.Println("  go test ./16_testing/calc -run '^$' -bench Factorial -cpuprofile cpu.prof")
+	fmt.Println("  go run ./30_profiling top cpu.prof 5")
+	fmt.Println("  go tool pprof -top cpu.prof")
+	fmt.Println("  go tool pprof -http=:8081 cpu.prof   # flame graph di browser")
+
+	// =============================================================================
+	// 7. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 7. Best Practices Profiling ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Ukur dulu, optimasi kemudian: profile menunjukkan bottleneck sebenarnya")
+	fmt.Println("   - Mulai dari fungsi dengan cum terbesar, lalu turun ke flat")
+	fmt.Println("   - Pakai make([]T, 0, n) jika jumlah elemen sudah diketahui")
+	fmt.Println("   - Konfirmasi perbaikan dengan benchmark (-benchmem) sebelum dan sesudah")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Mengubah semua parameter menjadi pointer \"agar cepat\" tanpa mengukur")
+	fmt.Println("   - Membaca profile dari program yang berjalan terlalu singkat (sample sedikit)")
+	fmt.Println("   - Menyalakan CPU profile terus-menerus di production tanpa alasan")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Jangan menebak: profile, ubah, lalu ukur lagi")
+	fmt.Println("================================================================================")
+}
+
+// topCommand menjalankan mode "top FILE [N]" dan mengembalikan exit code
+func topCommand(args []string) int {
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Fprintln(os.Stderr, "pemakaian: go run main.go top FILE [N]")
+		return 2
+	}
+	n := 10
+	if len(args) == 2 {
+		var err error
+		if n, err = strconv.Atoi(args[1]); err != nil || n <= 0 {
+			fmt.Fprintf(os.Stderr, "N harus bilangan positif: %q\n", args[1])
+			return 2
+		}
+	}
+	p, err := bacaProfile(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		return 1
+	}
+	// Pakai kolom nilai terakhir yang paling bermakna untuk tiap jenis profile.
+	// ParseProfile menjamin minimal ada satu sample_type.
+	tipe := p.SampleType[len(p.SampleType)-1].Type
+	for _, t := range []string{"cpu", "alloc_space"} {
+		if _, err := p.Index(t); err == nil {
+			tipe = t
+			break
+		}
+	}
+	fmt.Printf("%s (%v)\n", args[0], p.SampleType)
+	if err := cetakTop(p, tipe, n); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		return 1
+	}
+	return 0
+}
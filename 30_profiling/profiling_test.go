@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// =============================================================================
+// BENCHMARK BEBAN KERJA
+// =============================================================================
+// Jalankan dengan: go test -bench . -benchmem ./30_profiling
+// Setiap benchmark punya sub-benchmark berpasangan, sama seperti
+// perbandingan di bagian 4 main.go. Profile dari benchmark:
+//     go test ./30_profiling -run '^$' -bench Factorial -cpuprofile cpu.prof
+
+func BenchmarkFactorial(b *testing.B) {
+	b.Run("rekursif", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = factorial(20)
+		}
+	})
+	b.Run("iteratif", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = factorialIteratif(20)
+		}
+	})
+}
+
+func BenchmarkAppend10k(b *testing.B) {
+	b.Run("tanpa_make", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = kumpulkanTanpaPrealokasi(10_000)
+		}
+	})
+	b.Run("dengan_make", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = kumpulkanDenganPrealokasi(10_000)
+		}
+	})
+}
+
+func BenchmarkPerson(b *testing.B) {
+	kecil := Person{Nama: "Budi Santoso", Umur: 25, Alamat: "Jakarta"}
+	b.Run("value", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = umurValue(kecil)
+		}
+	})
+	b.Run("pointer", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = umurPointer(&kecil)
+		}
+	})
+}
+
+func BenchmarkPersonBesar(b *testing.B) {
+	besar := &PersonBesar{Person: Person{Nama: "Budi Santoso", Umur: 25}}
+	b.Run("value", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = umurBesarValue(*besar)
+		}
+	})
+	b.Run("pointer", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = umurBesarPointer(besar)
+		}
+	})
+}
+
+func BenchmarkBuatPerson(b *testing.B) {
+	b.Run("value", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sinkInt = buatPerson("Ani", 20).Umur
+		}
+	})
+	b.Run("pointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			sink = buatPersonPointer("Ani", 20)
+		}
+	})
+}
+
+// =============================================================================
+// KESIMPULAN DARI DATA PROFILE
+// =============================================================================
+
+func TestSimpulkan(t *testing.T) {
+	const ms = int64(time.Millisecond)
+	tests := []struct {
+		name       string
+		a, b       int64
+		period     int64
+		wantAwal   string // Awal kalimat kesimpulan
+		wantALebih bool
+	}{
+		{"260ms vs 230ms masih noise", 260 * ms, 230 * ms, 10 * ms, "selisih 3 sample, dalam noise", false},
+		{"900ms vs 250ms jelas berbeda", 900 * ms, 250 * ms, 10 * ms, "a 3.6x lebih lambat dari b", true},
+		{"b yang lebih lambat", 250 * ms, 900 * ms, 10 * ms, "b 3.6x lebih lambat dari a", false},
+		{"hanya satu tercatat", 90 * ms, 0, 10 * ms, "hanya a tercatat", true},
+		{"keduanya nol", 0, 0, 10 * ms, "keduanya tidak tercatat", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, aLebih := simpulkan("main.a", "main.b", tt.a, tt.b, tt.period, "nanoseconds")
+			if !strings.HasPrefix(got, tt.wantAwal) || aLebih != tt.wantALebih {
+				t.Errorf("simpulkan = %q, %v, want awalan %q, %v", got, aLebih, tt.wantAwal, tt.wantALebih)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// PARSER PROFILE
+// =============================================================================
+
+// TestParseProfileAllocs mem-parse profile allocs asli dari runtime/pprof
+// (protobuf yang di-gzip) dan mencari fungsi yang pasti mengalokasi
+func TestParseProfileAllocs(t *testing.T) {
+	rate := runtime.MemProfileRate
+	runtime.MemProfileRate = 1 // Catat setiap alokasi
+	t.Cleanup(func() { runtime.MemProfileRate = rate })
+
+	ulangBuatPersonPointer(1000)
+	runtime.GC()
+	var buf bytes.Buffer
+	if err := pprof.Lookup("allocs").WriteTo(&buf, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := ParseProfile(&buf)
+	if err != nil {
+		t.Fatalf("ParseProfile: %v", err)
+	}
+	wantTypes := []ValueType{
+		{"alloc_objects", "count"}, {"alloc_space", "bytes"},
+		{"inuse_objects", "count"}, {"inuse_space", "bytes"},
+	}
+	if !slices.Equal(p.SampleType, wantTypes) {
+		t.Errorf("SampleType = %v, want %v", p.SampleType, wantTypes)
+	}
+	if p.Period != 1 {
+		t.Errorf("Period = %d, want 1 (MemProfileRate)", p.Period)
+	}
+
+	rows, err := topTipe(p, "alloc_objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Di binary test, nama package adalah import path, bukan "main"
+	i := slices.IndexFunc(rows, func(r Baris) bool {
+		return strings.HasSuffix(r.Fungsi, ".ulangBuatPersonPointer")
+	})
+	if i < 0 || rows[i].Cum < 1000 {
+		t.Errorf("cum alloc_objects ulangBuatPersonPointer < 1000, rows = %v", rows[:min(5, len(rows))])
+	}
+}
+
+// TestParseProfileCPU hanya memeriksa struktur: jumlah sample CPU
+// bergantung pada mesin dan bisa nol untuk beban sesingkat ini
+func TestParseProfileCPU(t *testing.T) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		t.Skip("CPU profile sedang dipakai:", err)
+	}
+	sinkInt = ulangFactorial(1_000_000)
+	pprof.StopCPUProfile()
+
+	p, err := ParseProfile(&buf)
+	if err != nil {
+		t.Fatalf("ParseProfile: %v", err)
+	}
+	if _, err := p.Index("cpu"); err != nil {
+		t.Errorf("Index(cpu): %v, SampleType = %v", err, p.SampleType)
+	}
+	if p.Period != int64(10*time.Millisecond) {
+		t.Errorf("Period = %v, want 10ms", time.Duration(p.Period))
+	}
+}
+
+func TestParseProfileRusak(t *testing.T) {
+	// Protobuf kecil yang ditulis tangan. sampleType: {type: 1, unit: 2},
+	// strings: "", "cpu", "nanoseconds".
+	sampleType := []byte{0x0a, 0x04, 0x08, 0x01, 0x10, 0x02}
+	strs := []byte{0x32, 0x00, 0x32, 0x03, 'c', 'p', 'u', 0x32, 0x0b, 'n', 'a', 'n', 'o', 's', 'e', 'c', 'o', 'n', 'd', 's'}
+	satuNilai := []byte{0x12, 0x02, 0x10, 0x05}            // sample value: [5]
+	duaNilai := []byte{0x12, 0x04, 0x10, 0x05, 0x10, 0x06} // sample value: [5 6]
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"valid", slices.Concat(sampleType, satuNilai, strs), nil},
+		{"kosong", nil, errProfile},
+		{"tanpa sample_type", slices.Concat(satuNilai, strs), errProfile},
+		{"jumlah nilai salah", slices.Concat(sampleType, duaNilai, strs), errProfile},
+		{"panjang melebihi data", []byte{0x0a, 0x10, 0x08}, errProtobuf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseProfile(bytes.NewReader(tt.data))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseProfile error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := p.SampleType[0]; got != (ValueType{"cpu", "nanoseconds"}) {
+				t.Errorf("SampleType[0] = %v, want cpu/nanoseconds", got)
+			}
+		})
+	}
+}
+
+func TestTopKolomDiLuarBatas(t *testing.T) {
+	p := &Profile{
+		SampleType: []ValueType{{"alloc_objects", "count"}, {"alloc_space", "bytes"}},
+		Sample:     []Sample{{Stack: []string{"main.f"}, Value: []int64{1}}}, // Nilai kurang satu
+	}
+	for _, idx := range []int{-1, 1, 2} {
+		if _, _, err := p.Top(idx); !errors.Is(err, errProfile) {
+			t.Errorf("Top(%d) error = %v, want %v", idx, err, errProfile)
+		}
+	}
+	if rows, total, err := p.Top(0); err != nil || total != 1 || len(rows) != 1 {
+		t.Errorf("Top(0) = %v, %d, %v, want 1 baris total 1", rows, total, err)
+	}
+}
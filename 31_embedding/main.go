@@ -0,0 +1,481 @@
+/*
+================================================================================
+PELAJARAN 31: METHOD, EMBEDDING, DAN KOMPOSISI
+================================================================================
+
+Pelajaran 09 mendeklarasikan Manager di dalam main dengan Person yang
+di-embed, tetapi hanya memakai field yang dipromosikan (manager.Nama).
+Pelajaran ini membahas sisanya: method yang dipromosikan, override,
+selector ambigu, embed pointer vs value, dan embed interface.
+
+EMBEDDING BUKAN PEWARISAN
+-------------------------
+Go tidak punya class dan inheritance. Embedding hanyalah field TANPA NAMA
+yang field dan method-nya "dipromosikan" ke struct luar:
+
+    type Manager struct {
+        Person              // nama field-nya tetap ada: manager.Person
+        Department string
+    }
+    manager.Nama        → manager.Person.Nama        (field dipromosikan)
+    manager.Birthday()  → manager.Person.Birthday()  (method dipromosikan)
+
+ATURAN SELECTOR
+---------------
+┌───────────────────────────────────┬─────────────────────────────────────────┐
+│ Nama di kedalaman lebih dangkal   │ Menang (Manager.Perkenalan menimpa      │
+│                                   │ Person.Perkenalan)                      │
+│ Nama sama di kedalaman yang sama  │ AMBIGU: compile error jika dipakai,     │
+│                                   │ dan method itu hilang dari method set   │
+│ Tidak ada yang dipakai            │ Tidak error (ambigu hanya saat diakses) │
+└───────────────────────────────────┴─────────────────────────────────────────┘
+
+METHOD SET STRUCT DENGAN EMBED
+------------------------------
+┌─────────────────────────────┬──────────────────────┬────────────────────────┐
+│ Struct S                    │ Method set S         │ Method set *S          │
+├─────────────────────────────┼──────────────────────┼────────────────────────┤
+│ struct { Person }           │ receiver Person      │ Person dan *Person     │
+│ struct { *Person }          │ Person dan *Person   │ Person dan *Person     │
+└─────────────────────────────┴──────────────────────┴────────────────────────┘
+
+TIDAK ADA VIRTUAL METHOD
+------------------------
+Method Person yang memanggil p.Perkenalan() SELALU memanggil versi Person,
+walaupun dipanggil lewat Manager. Person tidak tahu ia di-embed.
+
+CEK COMPILE
+-----------
+Kasus yang TIDAK bisa di-compile ditulis sebagai data (contohKode) berikut
+pesan error compiler-nya. main_test.go memeriksa setiap contoh dengan
+go/types (type checker yang sama dengan gopls dan go vet) terhadap source
+main.go yang asli, sehingga program ini sendiri tidak perlu go/types:
+    go test ./31_embedding
+
+OUTPUT YANG DIHARAPKAN
+----------------------
+    go run main.go | diff - expected_output.txt
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// =============================================================================
+// PERSON DARI PELAJARAN 09
+// =============================================================================
+
+// Person adalah data seseorang
+type Person struct {
+	Nama   string
+	Umur   int
+	Alamat string
+}
+
+// Perkenalan memakai value receiver
+func (p Person) Perkenalan() string {
+	return fmt.Sprintf("Halo, nama saya %s, umur %d tahun", p.Nama, p.Umur)
+}
+
+// IsAdult mengecek umur dewasa (18 tahun ke atas)
+func (p Person) IsAdult() bool {
+	return p.Umur >= 18
+}
+
+// Birthday memakai pointer receiver karena mengubah Umur
+func (p *Person) Birthday() {
+	p.Umur++
+}
+
+// Sapa memanggil p.Perkenalan(). Karena receiver-nya Person, yang
+// dipanggil SELALU Person.Perkenalan, walaupun Sapa dipanggil lewat Manager.
+func (p Person) Sapa() string {
+	return p.Perkenalan() + ". Senang bertemu!"
+}
+
+// =============================================================================
+// OVERRIDE: MANAGER MENIMPA Perkenalan
+// =============================================================================
+
+// Manager meng-embed Person sebagai VALUE, seperti pelajaran 09
+type Manager struct {
+	Person
+	Department string
+}
+
+// Perkenalan menimpa (shadow) Person.Perkenalan. Versi Person tetap bisa
+// dipanggil lewat nama field embed: m.Person.Perkenalan().
+func (m Manager) Perkenalan() string {
+	return m.Person.Perkenalan() + ", manager divisi " + m.Department
+}
+
+// Tim meng-embed Manager yang meng-embed Person (dua tingkat)
+type Tim struct {
+	Manager
+	Anggota []string
+}
+
+// =============================================================================
+// SELECTOR AMBIGU: DUA TIPE DI-EMBED
+// =============================================================================
+
+// Kontak punya field Alamat dan method Perkenalan, sama seperti Person
+type Kontak struct {
+	Email  string
+	Alamat string // alamat kantor
+}
+
+// Perkenalan versi Kontak
+func (k Kontak) Perkenalan() string {
+	return "Hubungi saya di " + k.Email
+}
+
+// Konsultan meng-embed Person dan Kontak. Alamat dan Perkenalan ada di
+// KEDUANYA pada kedalaman yang sama, sehingga k.Alamat dan k.Perkenalan()
+// tidak bisa di-compile dan Konsultan TIDAK memenuhi interface Perkenal.
+type Konsultan struct {
+	Person
+	Kontak
+}
+
+// Freelancer sama dengan Konsultan, tetapi menyelesaikan ambiguitas dengan
+// mendefinisikan Perkenalan sendiri (kedalaman 0 selalu menang)
+type Freelancer struct {
+	Person
+	Kontak
+}
+
+// Perkenalan memilih dan menggabungkan kedua versi secara eksplisit
+func (f Freelancer) Perkenalan() string {
+	return f.Person.Perkenalan() + ". " + f.Kontak.Perkenalan()
+}
+
+// =============================================================================
+// EMBED POINTER VS VALUE
+// =============================================================================
+
+// Staf meng-embed *Person: beberapa Staf bisa berbagi satu Person, dan
+// method pointer receiver (Birthday) masuk method set Staf (bukan hanya *Staf)
+type Staf struct {
+	*Person
+	Jabatan string
+}
+
+// =============================================================================
+// INTERFACE
+// =============================================================================
+
+// Perkenal dipenuhi tipe yang bisa memperkenalkan diri
+type Perkenal interface {
+	Perkenalan() string
+}
+
+// Ulangtahun dipenuhi tipe yang umurnya bisa bertambah
+type Ulangtahun interface {
+	Birthday()
+}
+
+// Assertion saat compile: jika salah satu baris ini salah, program tidak
+// bisa di-build. Variabel _ tidak memakan memory.
+var (
+	_ Perkenal   = Person{}
+	_ Perkenal   = Manager{}    // Manager.Perkenalan
+	_ Perkenal   = Tim{}        // dipromosikan dari Manager
+	_ Perkenal   = Freelancer{} // ambiguitas diselesaikan
+	_ Ulangtahun = (*Person)(nil)
+	_ Ulangtahun = (*Manager)(nil) // Manager (value) TIDAK memenuhi
+	_ Ulangtahun = Staf{}          // embed pointer: value pun memenuhi
+)
+
+// =============================================================================
+// EMBED INTERFACE: DECORATOR
+// =============================================================================
+
+// ErrTidakDitemukan dikembalikan Repository jika nama tidak ada
+var ErrTidakDitemukan = errors.New("person tidak ditemukan")
+
+// Repository menyimpan dan mencari Person
+type Repository interface {
+	Simpan(p Person) error
+	Cari(nama string) (Person, error)
+}
+
+// memRepo adalah implementasi Repository di memory
+type memRepo struct {
+	data map[string]Person
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{data: make(map[string]Person)}
+}
+
+func (r *memRepo) Simpan(p Person) error {
+	r.data[p.Nama] = p
+	return nil
+}
+
+func (r *memRepo) Cari(nama string) (Person, error) {
+	p, ok := r.data[nama]
+	if !ok {
+		return Person{}, fmt.Errorf("cari %q: %w", nama, ErrTidakDitemukan)
+	}
+	return p, nil
+}
+
+// loggingRepo meng-embed INTERFACE Repository. Hanya Simpan yang ditimpa;
+// Cari otomatis diteruskan ke Repository di dalamnya.
+type loggingRepo struct {
+	Repository
+	log io.Writer
+}
+
+func (r loggingRepo) Simpan(p Person) error {
+	fmt.Fprintf(r.log, "  [log] simpan %s\n", p.Nama)
+	return r.Repository.Simpan(p)
+}
+
+// hitungRepo menghitung pencarian yang gagal; Simpan diteruskan apa adanya
+type hitungRepo struct {
+	Repository
+	gagal int
+}
+
+func (r *hitungRepo) Cari(nama string) (Person, error) {
+	p, err := r.Repository.Cari(nama)
+	if err != nil {
+		r.gagal++
+	}
+	return p, err
+}
+
+var (
+	_ Repository = loggingRepo{}
+	_ Repository = (*hitungRepo)(nil)
+)
+
+// =============================================================================
+// CEK COMPILE
+// =============================================================================
+
+// contohKode adalah satu potongan kode beserta pesan error compiler-nya
+// ("" jika lolos). main_test.go memeriksa setiap pesan dengan go/types
+// terhadap tipe asli di file ini, jadi teks di bawah tidak bisa basi.
+type contohKode struct {
+	Kode, Error string
+}
+
+var (
+	cekKonsultan = []contohKode{
+		{"func f(k Konsultan) string { return k.Alamat }", "ambiguous selector k.Alamat"},
+		{"func f(k Konsultan) string { return k.Perkenalan() }", "ambiguous selector k.Perkenalan"},
+		{"var _ Perkenal = Konsultan{}", "cannot use Konsultan{} (value of struct type Konsultan) as Perkenal value in variable declaration: " +
+			"Konsultan does not implement Perkenal (ambiguous selector Konsultan.Perkenalan)"},
+		{"func f(k Konsultan) string { return k.Person.Perkenalan() }", ""},
+	}
+	cekMethodSet = []contohKode{
+		{"var _ Ulangtahun = Manager{}", "cannot use Manager{} (value of struct type Manager) as Ulangtahun value in variable declaration: " +
+			"Manager does not implement Ulangtahun (method Birthday has pointer receiver)"},
+		{"var _ Ulangtahun = &Manager{}", ""},
+		{"func f() { Manager{}.Birthday() }", "cannot call pointer method Birthday on Manager"},
+	}
+	cekDecorator = []contohKode{
+		{"var _ Repository = loggingRepo{}", ""},
+		{`func f() { _, _ = loggingRepo{}.Cari("Budi") }`, ""},
+	}
+)
+
+// cetakCek mencetak setiap potongan kode dan hasil compile-nya
+func cetakCek(daftar []contohKode) {
+	for _, c := range daftar {
+		if c.Error == "" {
+			fmt.Printf("  ✓ %s\n", c.Kode)
+			continue
+		}
+		fmt.Printf("  ✗ %s\n      → %s\n", c.Kode, c.Error)
+	}
+}
+
+// tangkapPanic menjalankan fn dan mengembalikan pesan panic-nya, jika ada
+func tangkapPanic(fn func()) (pesan string) {
+	defer func() {
+		if r := recover(); r != nil {
+			pesan = fmt.Sprint(r)
+		}
+	}()
+	fn()
+	return ""
+}
+
+// =============================================================================
+// MAIN FUNCTION
+// =============================================================================
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("METHOD, EMBEDDING, DAN KOMPOSISI")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. FIELD DAN METHOD YANG DIPROMOSIKAN
+	// =============================================================================
+	fmt.Println("--- 1. Field dan Method yang Dipromosikan ---")
+
+	// Persis seperti pelajaran 09: tipe lokal tanpa method sendiri.
+	// (Tipe yang dideklarasikan di dalam fungsi tidak bisa punya method.)
+	type Manager09 struct {
+		Person
+		Department string
+	}
+	m09 := Manager09{
+		Person:     Person{Nama: "Direktur", Umur: 45, Alamat: "Kantor Pusat"},
+		Department: "IT",
+	}
+
+	fmt.Println("m09.Nama          :", m09.Nama)
+	fmt.Println("m09.Perkenalan()  :", m09.Perkenalan()) // = m09.Person.Perkenalan()
+	fmt.Println("m09.IsAdult()     :", m09.IsAdult())
+	m09.Birthday() // = (&m09.Person).Birthday(): m09 addressable, jadi boleh
+	fmt.Println("Setelah Birthday():", m09.Umur)
+	fmt.Printf("Nama field embed  : m09.Person = %+v\n", m09.Person)
+
+	// =============================================================================
+	// 2. OVERRIDE (SHADOWING) METHOD
+	// =============================================================================
+	fmt.Println("\n--- 2. Override: Manager.Perkenalan ---")
+
+	manager := Manager{
+		Person:     Person{Nama: "Direktur", Umur: 45, Alamat: "Kantor Pusat"},
+		Department: "IT",
+	}
+	fmt.Println("manager.Perkenalan()       :", manager.Perkenalan())
+	fmt.Println("manager.Person.Perkenalan():", manager.Person.Perkenalan())
+
+	// Sapa milik Person memanggil Person.Perkenalan, BUKAN Manager.Perkenalan
+	fmt.Println("manager.Sapa()             :", manager.Sapa())
+	fmt.Println("→ Tidak ada virtual method: Person tidak tahu ia di-embed Manager")
+
+	// Lewat interface, yang dipanggil adalah method set tipe dinamisnya
+	for _, p := range []Perkenal{manager.Person, manager} {
+		fmt.Printf("Perkenal berisi %-12s: %s\n", fmt.Sprintf("%T", p), p.Perkenalan())
+	}
+
+	// Kedalaman: Tim → Manager (1) → Person (2); yang lebih dangkal menang
+	tim := Tim{Manager: manager, Anggota: []string{"Budi", "Ani"}}
+	fmt.Println("tim.Perkenalan()           :", tim.Perkenalan())
+	fmt.Println("tim.Nama (kedalaman 2)     :", tim.Nama)
+
+	// =============================================================================
+	// 3. SELECTOR AMBIGU
+	// =============================================================================
+	fmt.Println("\n--- 3. Selector Ambigu: Person + Kontak ---")
+
+	k := Konsultan{
+		Person: Person{Nama: "Citra", Umur: 30, Alamat: "Bandung"},
+		Kontak: Kontak{Email: "citra@contoh.id", Alamat: "Jl. Asia Afrika 8"},
+	}
+	// Field yang hanya ada di satu tipe tetap dipromosikan
+	fmt.Println("k.Nama  (hanya Person):", k.Nama)
+	fmt.Println("k.Email (hanya Kontak):", k.Email)
+	// Field yang ada di keduanya harus ditulis lengkap
+	fmt.Println("k.Person.Alamat       :", k.Person.Alamat)
+	fmt.Println("k.Kontak.Alamat       :", k.Kontak.Alamat)
+
+	_, ok := any(k).(Perkenal)
+	fmt.Println("Konsultan memenuhi Perkenal?", ok)
+
+	fmt.Println("Cek compile:")
+	cetakCek(cekKonsultan)
+
+	f := Freelancer{Person: k.Person, Kontak: k.Kontak}
+	fmt.Println("Freelancer mendefinisikan Perkenalan sendiri:")
+	fmt.Println(" ", f.Perkenalan())
+
+	// =============================================================================
+	// 4. EMBED POINTER VS VALUE
+	// =============================================================================
+	fmt.Println("\n--- 4. Embed Pointer vs Value ---")
+
+	// Value: setiap salinan Manager punya Person sendiri
+	m1 := manager
+	m2 := m1
+	m2.Birthday()
+	fmt.Printf("Manager (value) : m1.Umur=%d m2.Umur=%d → salinan terpisah\n", m1.Umur, m2.Umur)
+
+	// Pointer: dua Staf berbagi satu Person
+	dewi := &Person{Nama: "Dewi", Umur: 27, Alamat: "Surabaya"}
+	s1 := Staf{Person: dewi, Jabatan: "Analis"}
+	s2 := Staf{Person: dewi, Jabatan: "Koordinator"}
+	s2.Birthday()
+	fmt.Printf("Staf (*Person)  : s1.Umur=%d s2.Umur=%d → Person yang sama\n", s1.Umur, s2.Umur)
+
+	// Method set: Manager{} tidak punya Birthday, Staf{} punya
+	var u Ulangtahun = s1
+	u.Birthday()
+	fmt.Println("Staf sebagai Ulangtahun, umur Dewi sekarang:", dewi.Umur)
+	fmt.Println("Cek compile:")
+	cetakCek(cekMethodSet)
+
+	// Embed pointer bisa nil: akses field atau method-nya panic
+	kosong := Staf{Jabatan: "Magang"}
+	fmt.Println("Staf tanpa Person, Jabatan:", kosong.Jabatan)
+	fmt.Println("kosong.Nama → panic:", tangkapPanic(func() { _ = kosong.Nama }))
+
+	// =============================================================================
+	// 5. EMBED INTERFACE: DECORATOR
+	// =============================================================================
+	fmt.Println("\n--- 5. Embed Interface untuk Decorator ---")
+
+	hitung := &hitungRepo{Repository: loggingRepo{Repository: newMemRepo(), log: os.Stdout}}
+	var repo Repository = hitung
+
+	for _, p := range []Person{manager.Person, k.Person, *dewi} {
+		if err := repo.Simpan(p); err != nil { // hitungRepo → loggingRepo → memRepo
+			fmt.Println("error:", err)
+		}
+	}
+	for _, nama := range []string{"Citra", "Eko", "Dewi", "Fajar"} {
+		p, err := repo.Cari(nama) // hitungRepo.Cari → loggingRepo (promosi) → memRepo
+		switch {
+		case errors.Is(err, ErrTidakDitemukan):
+			fmt.Println("  cari:", err)
+		case err != nil:
+			fmt.Println("  error:", err)
+		default:
+			fmt.Println("  cari:", p.Perkenalan())
+		}
+	}
+	fmt.Println("Pencarian gagal:", hitung.gagal)
+
+	// Interface yang di-embed tapi tidak diisi bernilai nil: panic saat dipanggil
+	fmt.Println("loggingRepo{} tanpa Repository:")
+	fmt.Println("  Cari → panic:", tangkapPanic(func() { _, _ = loggingRepo{}.Cari("Budi") }))
+	fmt.Println("Cek compile: lolos, jadi kesalahan ini baru ketahuan saat runtime")
+	cetakCek(cekDecorator)
+
+	// =============================================================================
+	// 6. BEST PRACTICES
+	// =============================================================================
+	fmt.Println("\n--- 6. Best Practices Embedding ---")
+
+	fmt.Println("\n✅ DO:")
+	fmt.Println("   - Embed untuk komposisi \"punya perilaku yang sama\", bukan hierarki kelas")
+	fmt.Println("   - Tulis assertion var _ Interface = Tipe{} untuk menjaga method set")
+	fmt.Println("   - Selesaikan ambiguitas dengan method di tipe luar atau selector lengkap")
+	fmt.Println("   - Embed interface untuk decorator yang hanya menimpa sebagian method")
+
+	fmt.Println("\n❌ DON'T:")
+	fmt.Println("   - Mengharapkan method Person memanggil override Manager (tidak ada virtual)")
+	fmt.Println("   - Embed tipe di struct exported jika method-nya tidak ingin ikut diekspor")
+	fmt.Println("   - Lupa mengisi embed pointer atau interface (nil → panic saat dipakai)")
+	fmt.Println("   - Menyalin struct yang meng-embed sync.Mutex (pakai pointer receiver)")
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Embedding: komposisi dengan promosi field dan method")
+	fmt.Println("================================================================================")
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// cekCompile menambahkan kode sebagai file baru di package ini lalu
+// memeriksa seluruh package dengan go/types. Hasilnya pesan error compiler
+// tanpa posisi baris, atau "" jika lolos.
+func cekCompile(t *testing.T, conf *types.Config, fset *token.FileSet, files []*ast.File, kode string) string {
+	t.Helper()
+	f, err := parser.ParseFile(fset, "cek.go", "package main\n\n"+kode, 0)
+	if err != nil {
+		t.Fatalf("kode contoh tidak valid: %v", err)
+	}
+	_, err = conf.Check("main", fset, append(files[:len(files):len(files)], f), nil)
+	if err == nil {
+		return ""
+	}
+	var terr types.Error
+	if !errors.As(err, &terr) {
+		t.Fatalf("error bukan types.Error: %v", err)
+	}
+	if terr.Fset.Position(terr.Pos).Filename != "cek.go" {
+		t.Fatalf("error di luar kode contoh: %v", err)
+	}
+	return terr.Msg
+}
+
+// parsePackage mem-parse semua file non-test di direktori ini
+func parsePackage(t *testing.T, fset *token.FileSet) []*ast.File {
+	t.Helper()
+	names, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+func TestContohKode(t *testing.T) {
+	fset := token.NewFileSet()
+	files := parsePackage(t, fset)
+	// Importer "source" membaca package standar dari GOROOT dan menyimpan
+	// hasilnya, jadi dipakai bersama oleh semua kasus
+	conf := &types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+
+	for _, daftar := range [][]contohKode{cekKonsultan, cekMethodSet, cekDecorator} {
+		for _, c := range daftar {
+			t.Run(c.Kode, func(t *testing.T) {
+				if got := cekCompile(t, conf, fset, files, c.Kode); got != c.Error {
+					t.Errorf("error compile = %q, want %q", got, c.Error)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Program contoh penggunaan package gradebook.
+// Jalankan dari root repository: go run ./gradebook/cmd/gradebook
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"learn-go/gradebook"
+)
+
+func main() {
+	fmt.Println("================================================================================")
+	fmt.Println("PROYEK: GRADEBOOK")
+	fmt.Println("================================================================================")
+	fmt.Println()
+
+	// =============================================================================
+	// 1. DAFTARKAN MAHASISWA DAN NILAI
+	// =============================================================================
+	fmt.Println("--- 1. Daftarkan Mahasiswa dan Nilai ---")
+
+	book := gradebook.New()
+
+	students := []struct{ nama, nim, jurusan string }{
+		{"Budi Santoso", "2023001", "Teknik Informatika"},
+		{"Ani Wijaya", "2023002", "Sistem Informasi"},
+		{"Caca Handika", "2023003", "Teknik Informatika"},
+		{"Doni Pratama", "2023004", "Matematika"},
+	}
+	for _, s := range students {
+		if err := book.AddStudent(s.nama, s.nim, s.jurusan); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+
+	// Nilai per mata kuliah, sama seperti map nilai di pelajaran 07_map
+	scores := map[string]map[string]int{
+		"2023001": {"matematika": 90, "fisika": 85, "kimia": 88, "biologi": 92},
+		"2023002": {"matematika": 75, "fisika": 70, "kimia": 72},
+		"2023003": {"matematika": 60, "fisika": 65, "kimia": 58, "biologi": 62},
+		"2023004": {"matematika": 80, "fisika": 75, "kimia": 72, "biologi": 70},
+	}
+	for nim, nilai := range scores {
+		for subject, score := range nilai {
+			if err := book.RecordScore(nim, subject, score); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			}
+		}
+	}
+	fmt.Printf("%d mahasiswa terdaftar, mata kuliah: %v\n", len(book.Students()), book.Subjects())
+
+	// =============================================================================
+	// 2. VALIDASI INPUT
+	// =============================================================================
+	fmt.Println("\n--- 2. Validasi Input ---")
+
+	inputErrs := []error{
+		book.AddStudent("Budi Lain", "2023001", "Fisika"),
+		book.RecordScore("9999999", "matematika", 80),
+		book.RecordScore("2023001", "matematika", 120),
+	}
+	for _, err := range inputErrs {
+		fmt.Printf("Error: %v\n", err)
+	}
+	fmt.Printf("errors.Is(err, ErrInvalidScore): %v\n", errors.Is(inputErrs[2], gradebook.ErrInvalidScore))
+
+	// =============================================================================
+	// 3. LAPORAN TEKS
+	// =============================================================================
+	fmt.Println("\n--- 3. Laporan Teks (Threshold Default) ---")
+	if err := book.WriteText(os.Stdout); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	// =============================================================================
+	// 4. THRESHOLD KUSTOM
+	// =============================================================================
+	// Tabel grade bisa diganti tanpa mengubah kode if-else
+	fmt.Println("\n--- 4. Threshold Kustom ---")
+
+	strict := gradebook.New(
+		gradebook.Threshold{Min: 85, Grade: "A"},
+		gradebook.Threshold{Min: 75, Grade: "B"},
+		gradebook.Threshold{Min: 65, Grade: "C"},
+		gradebook.Threshold{Min: 50, Grade: "D"},
+		gradebook.Threshold{Min: 0, Grade: "E"},
+	)
+	for _, avg := range []float64{88.75, 72.33, 61.25} {
+		fmt.Printf("Rata-rata %.2f: default=%s, ketat=%s\n", avg, book.Grade(avg), strict.Grade(avg))
+	}
+
+	// =============================================================================
+	// 5. EXPORT CSV DAN JSON
+	// =============================================================================
+	fmt.Println("\n--- 5. Export CSV ---")
+	if err := book.WriteCSV(os.Stdout); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	fmt.Println("\n--- 6. Export JSON ---")
+	if err := book.WriteJSON(os.Stdout); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+
+	fmt.Println("\n================================================================================")
+	fmt.Println("SELESAI - Gradebook menggabungkan map, kondisi, perulangan, struct, dan error")
+	fmt.Println("================================================================================")
+}
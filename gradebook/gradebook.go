@@ -0,0 +1,238 @@
+/*
+================================================================================
+PROYEK: GRADEBOOK (BUKU NILAI MAHASISWA)
+================================================================================
+
+Package gradebook menggabungkan materi sebelumnya menjadi aplikasi kecil:
+- 04_kondisi    : konversi nilai angka ke huruf (A/B/C/D), kini lewat tabel
+- 05_perulangan : menjumlahkan nilai untuk menghitung rata-rata
+- 07_map        : nilai per mata kuliah disimpan di map[string]int
+- 09_struct     : data mahasiswa (nama, nim, jurusan) sebagai struct
+- 11_error      : error untuk input yang tidak valid
+
+TABEL GRADE
+-----------
+Threshold tidak lagi di-hardcode dengan if-else. Setiap grade punya batas
+bawah, grade pertama yang batas bawahnya terpenuhi akan dipakai:
+┌───────┬──────────────┐
+│ Grade │ Rata-rata    │
+├───────┼──────────────┤
+│ A     │ >= 80        │
+│ B     │ >= 70        │
+│ C     │ >= 60        │
+│ D     │ < 60         │
+└───────┴──────────────┘
+*/
+package gradebook
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Error yang bisa dicek dengan errors.Is
+var (
+	ErrStudentNotFound  = errors.New("mahasiswa tidak ditemukan")
+	ErrDuplicateStudent = errors.New("NIM sudah terdaftar")
+	ErrInvalidStudent   = errors.New("data mahasiswa tidak valid")
+	ErrInvalidScore     = errors.New("nilai harus di antara 0 dan 100")
+)
+
+// =============================================================================
+// TABEL GRADE
+// =============================================================================
+
+// Threshold adalah satu baris tabel grade: rata-rata >= Min mendapat Grade
+type Threshold struct {
+	Min   float64
+	Grade string
+}
+
+// DefaultThresholds sama dengan aturan if-else di pelajaran 04_kondisi
+var DefaultThresholds = []Threshold{
+	{Min: 80, Grade: "A"},
+	{Min: 70, Grade: "B"},
+	{Min: 60, Grade: "C"},
+	{Min: 0, Grade: "D"},
+}
+
+// =============================================================================
+// MAHASISWA
+// =============================================================================
+
+// Student menyimpan data mahasiswa (field sama dengan map mahasiswa di 07_map)
+// beserta nilai per mata kuliah
+type Student struct {
+	Nama    string
+	NIM     string
+	Jurusan string
+	Nilai   map[string]int // mata kuliah -> nilai
+}
+
+// Average menghitung rata-rata nilai, 0 jika belum ada nilai
+func (s Student) Average() float64 {
+	if len(s.Nilai) == 0 {
+		return 0
+	}
+	total := 0
+	for _, n := range s.Nilai {
+		total += n
+	}
+	return float64(total) / float64(len(s.Nilai))
+}
+
+// =============================================================================
+// GRADEBOOK
+// =============================================================================
+
+// Gradebook mengelola banyak mahasiswa dan nilai mereka
+// Gunakan New untuk membuat Gradebook baru
+type Gradebook struct {
+	students   map[string]*Student // NIM -> mahasiswa
+	order      []string            // Urutan NIM saat didaftarkan
+	thresholds []Threshold         // Diurutkan dari Min terbesar
+}
+
+// New membuat Gradebook dengan tabel grade yang diberikan.
+// Jika thresholds kosong, DefaultThresholds yang dipakai.
+func New(thresholds ...Threshold) *Gradebook {
+	if len(thresholds) == 0 {
+		thresholds = DefaultThresholds
+	}
+	// Copy agar slice milik pemanggil tidak ikut terurut/berubah
+	sorted := make([]Threshold, len(thresholds))
+	copy(sorted, thresholds)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Min > sorted[j].Min })
+
+	return &Gradebook{
+		students:   make(map[string]*Student),
+		thresholds: sorted,
+	}
+}
+
+// AddStudent mendaftarkan mahasiswa baru, NIM harus unik
+func (g *Gradebook) AddStudent(nama, nim, jurusan string) error {
+	if nama == "" || nim == "" {
+		return fmt.Errorf("%w: nama dan NIM wajib diisi", ErrInvalidStudent)
+	}
+	if _, exists := g.students[nim]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateStudent, nim)
+	}
+	g.students[nim] = &Student{
+		Nama:    nama,
+		NIM:     nim,
+		Jurusan: jurusan,
+		Nilai:   make(map[string]int),
+	}
+	g.order = append(g.order, nim)
+	return nil
+}
+
+// RecordScore mencatat (atau mengganti) nilai mata kuliah untuk mahasiswa
+func (g *Gradebook) RecordScore(nim, subject string, score int) error {
+	student, ok := g.students[nim]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrStudentNotFound, nim)
+	}
+	if score < 0 || score > 100 {
+		return fmt.Errorf("%w: %s = %d", ErrInvalidScore, subject, score)
+	}
+	student.Nilai[subject] = score
+	return nil
+}
+
+// Student mengembalikan COPY data mahasiswa, sehingga pemanggil tidak bisa
+// mengubah isi gradebook tanpa lewat RecordScore
+func (g *Gradebook) Student(nim string) (Student, bool) {
+	student, ok := g.students[nim]
+	if !ok {
+		return Student{}, false
+	}
+	copied := *student
+	copied.Nilai = make(map[string]int, len(student.Nilai))
+	for subject, score := range student.Nilai {
+		copied.Nilai[subject] = score
+	}
+	return copied, true
+}
+
+// Students mengembalikan semua mahasiswa sesuai urutan pendaftaran
+func (g *Gradebook) Students() []Student {
+	result := make([]Student, 0, len(g.order))
+	for _, nim := range g.order {
+		student, _ := g.Student(nim)
+		result = append(result, student)
+	}
+	return result
+}
+
+// Grade mengonversi rata-rata menjadi grade huruf sesuai tabel
+// Nilai di bawah semua threshold mendapat grade terakhir (terendah)
+func (g *Gradebook) Grade(average float64) string {
+	for _, t := range g.thresholds {
+		if average >= t.Min {
+			return t.Grade
+		}
+	}
+	return g.thresholds[len(g.thresholds)-1].Grade
+}
+
+// Subjects mengembalikan semua mata kuliah yang pernah dinilai, terurut abjad
+func (g *Gradebook) Subjects() []string {
+	seen := make(map[string]bool)
+	var subjects []string
+	for _, student := range g.students {
+		for subject := range student.Nilai {
+			if !seen[subject] {
+				seen[subject] = true
+				subjects = append(subjects, subject)
+			}
+		}
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+// =============================================================================
+// RANKING
+// =============================================================================
+
+// RankEntry adalah satu baris ranking kelas
+type RankEntry struct {
+	Rank    int
+	Student Student
+	Average float64
+	Grade   string
+}
+
+// Ranking mengurutkan mahasiswa dari rata-rata tertinggi.
+// Rata-rata yang sama mendapat peringkat sama (1, 1, 3, ...),
+// urutan di antara mereka ditentukan NIM agar hasilnya selalu sama.
+func (g *Gradebook) Ranking() []RankEntry {
+	entries := make([]RankEntry, 0, len(g.order))
+	for _, student := range g.Students() {
+		avg := student.Average()
+		entries = append(entries, RankEntry{
+			Student: student,
+			Average: avg,
+			Grade:   g.Grade(avg),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Average != entries[j].Average {
+			return entries[i].Average > entries[j].Average
+		}
+		return entries[i].Student.NIM < entries[j].Student.NIM
+	})
+
+	for i := range entries {
+		if i > 0 && entries[i].Average == entries[i-1].Average {
+			entries[i].Rank = entries[i-1].Rank
+		} else {
+			entries[i].Rank = i + 1
+		}
+	}
+	return entries
+}
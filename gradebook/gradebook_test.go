@@ -0,0 +1,196 @@
+package gradebook
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// newTestGradebook membuat gradebook kecil dengan dua rata-rata yang sama
+// (Budi dan Citra, 75) untuk menguji peringkat seri
+func newTestGradebook(t *testing.T) *Gradebook {
+	t.Helper()
+	g := New()
+	students := []struct {
+		nama, nim string
+		nilai     map[string]int
+	}{
+		{"Citra", "003", map[string]int{"Algoritma": 80, "Basis Data": 70}},
+		{"Andi", "001", map[string]int{"Algoritma": 90, "Basis Data": 85}},
+		{"Budi", "002", map[string]int{"Algoritma": 70, "Basis Data": 80}},
+		{"Dewi", "004", map[string]int{"Algoritma": 65, "Basis Data": 72, "Jaringan": 60}},
+	}
+	for _, s := range students {
+		if err := g.AddStudent(s.nama, s.nim, "Informatika"); err != nil {
+			t.Fatal(err)
+		}
+		for subject, score := range s.nilai {
+			if err := g.RecordScore(s.nim, subject, score); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return g
+}
+
+func TestGrade(t *testing.T) {
+	tests := []struct {
+		average float64
+		want    string
+	}{
+		{100, "A"},
+		{80, "A"},
+		{79.99, "B"},
+		{70, "B"},
+		{69.99, "C"},
+		{60, "C"},
+		{59.99, "D"},
+		{0, "D"},
+		{-1, "D"}, // di bawah semua threshold: grade terendah
+	}
+
+	g := New()
+	for _, tt := range tests {
+		if got := g.Grade(tt.average); got != tt.want {
+			t.Errorf("Grade(%v) = %s, want %s", tt.average, got, tt.want)
+		}
+	}
+}
+
+func TestRecordScoreErrors(t *testing.T) {
+	g := New()
+	if err := g.AddStudent("Andi", "001", "Informatika"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		nim     string
+		score   int
+		wantErr error
+	}{
+		{"nilai valid", "001", 100, nil},
+		{"nilai negatif", "001", -1, ErrInvalidScore},
+		{"nilai di atas 100", "001", 101, ErrInvalidScore},
+		{"NIM tidak terdaftar", "999", 80, ErrStudentNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := g.RecordScore(tt.nim, "Algoritma", tt.score)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RecordScore error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := g.AddStudent("Andi", "001", ""); !errors.Is(err, ErrDuplicateStudent) {
+		t.Errorf("AddStudent NIM ganda error = %v, want %v", err, ErrDuplicateStudent)
+	}
+}
+
+func TestRankingTies(t *testing.T) {
+	g := newTestGradebook(t)
+
+	want := []struct {
+		rank int
+		nim  string
+	}{
+		{1, "001"}, // 87.5
+		{2, "002"}, // 75, seri dengan 003: diurutkan NIM
+		{2, "003"}, // 75
+		{4, "004"}, // 65.67, peringkat 3 dilewati
+	}
+
+	got := g.Ranking()
+	if len(got) != len(want) {
+		t.Fatalf("Ranking() berisi %d entry, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Rank != w.rank || got[i].Student.NIM != w.nim {
+			t.Errorf("Ranking()[%d] = #%d %s, want #%d %s",
+				i, got[i].Rank, got[i].Student.NIM, w.rank, w.nim)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestGradebook(t).WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV tidak valid: %v", err)
+	}
+	wantHeader := "Peringkat,NIM,Nama,Jurusan,Algoritma,Basis Data,Jaringan,Rata-rata,Grade"
+	if got := strings.Join(records[0], ","); got != wantHeader {
+		t.Errorf("header = %s, want %s", got, wantHeader)
+	}
+	// Baris terakhir: Dewi, satu-satunya yang punya nilai Jaringan
+	wantLast := "4,004,Dewi,Informatika,65,72,60,65.67,C"
+	if got := strings.Join(records[len(records)-1], ","); got != wantLast {
+		t.Errorf("baris terakhir = %s, want %s", got, wantLast)
+	}
+	// Mata kuliah yang belum dinilai ditulis "-"
+	if got := records[1][6]; got != "-" {
+		t.Errorf("Jaringan untuk Andi = %q, want \"-\"", got)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestGradebook(t).WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var report jsonReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("JSON tidak valid: %v", err)
+	}
+	if len(report.Mahasiswa) != 4 {
+		t.Fatalf("jumlah mahasiswa = %d, want 4", len(report.Mahasiswa))
+	}
+
+	dewi := report.Mahasiswa[3]
+	if dewi.NIM != "004" || dewi.Peringkat != 4 || dewi.Grade != "C" {
+		t.Errorf("mahasiswa terakhir = %+v, want NIM 004 peringkat 4 grade C", dewi)
+	}
+	// 197/3 = 65.666..., dibulatkan sama seperti laporan text dan CSV
+	if dewi.RataRata != 65.67 {
+		t.Errorf("rata_rata = %v, want 65.67", dewi.RataRata)
+	}
+	if !strings.Contains(buf.String(), `"rata_rata": 65.67,`) {
+		t.Errorf("JSON tidak memuat rata_rata 65.67:\n%s", buf.String())
+	}
+}
+
+// Laporan kosong tetap punya bentuk JSON yang sama: array, bukan null
+func TestWriteJSONKosong(t *testing.T) {
+	var buf bytes.Buffer
+	if err := New().WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "{\n  \"mata_kuliah\": [],\n  \"mahasiswa\": []\n}\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteJSON kosong = %q, want %q", got, want)
+	}
+}
+
+func TestWriteTextMatchesCSV(t *testing.T) {
+	g := newTestGradebook(t)
+	var text bytes.Buffer
+	if err := g.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("laporan text berisi %d baris, want 5", len(lines))
+	}
+	if fields := strings.Fields(lines[4]); fields[len(fields)-2] != "65.67" {
+		t.Errorf("rata-rata di text = %s, want 65.67", fields[len(fields)-2])
+	}
+}
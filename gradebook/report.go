@@ -0,0 +1,137 @@
+package gradebook
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+// =============================================================================
+// EXPORT LAPORAN
+// =============================================================================
+// Semua format memakai data yang sama dari Ranking(), sehingga isi laporan
+// text, CSV, dan JSON selalu konsisten.
+
+// formatAverage membulatkan rata-rata ke 2 desimal. Dipakai text, CSV, dan
+// JSON sehingga ketiga format menampilkan angka yang persis sama.
+func formatAverage(avg float64) string {
+	return strconv.FormatFloat(avg, 'f', 2, 64)
+}
+
+// roundAverage adalah formatAverage dalam bentuk float64 untuk JSON
+// (72.33333333333333 menjadi 72.33)
+func roundAverage(avg float64) float64 {
+	rounded, _ := strconv.ParseFloat(formatAverage(avg), 64)
+	return rounded
+}
+
+// reportHeader mengembalikan nama kolom laporan untuk daftar mata kuliah
+func reportHeader(subjects []string) []string {
+	header := []string{"Peringkat", "NIM", "Nama", "Jurusan"}
+	header = append(header, subjects...)
+	return append(header, "Rata-rata", "Grade")
+}
+
+// reportRow mengubah satu RankEntry menjadi kolom-kolom string
+// Mata kuliah yang belum dinilai ditulis "-"
+func reportRow(entry RankEntry, subjects []string) []string {
+	row := []string{
+		strconv.Itoa(entry.Rank),
+		entry.Student.NIM,
+		entry.Student.Nama,
+		entry.Student.Jurusan,
+	}
+	for _, subject := range subjects {
+		if score, ok := entry.Student.Nilai[subject]; ok {
+			row = append(row, strconv.Itoa(score))
+		} else {
+			row = append(row, "-")
+		}
+	}
+	return append(row, formatAverage(entry.Average), entry.Grade)
+}
+
+// WriteText menulis laporan sebagai tabel teks yang rata kolomnya
+// text/tabwriter menghitung lebar kolom secara otomatis
+func (g *Gradebook) WriteText(w io.Writer) error {
+	subjects := g.Subjects()
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	writeLine := func(cols []string) {
+		for i, col := range cols {
+			if i > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, col)
+		}
+		fmt.Fprintln(tw)
+	}
+
+	writeLine(reportHeader(subjects))
+	for _, entry := range g.Ranking() {
+		writeLine(reportRow(entry, subjects))
+	}
+	// Flush wajib dipanggil, tabwriter menahan output sampai semua baris diketahui
+	return tw.Flush()
+}
+
+// WriteCSV menulis laporan dalam format CSV (bisa dibuka di spreadsheet)
+func (g *Gradebook) WriteCSV(w io.Writer) error {
+	subjects := g.Subjects()
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(reportHeader(subjects)); err != nil {
+		return err
+	}
+	for _, entry := range g.Ranking() {
+		if err := cw.Write(reportRow(entry, subjects)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// jsonStudent adalah bentuk JSON satu mahasiswa di laporan
+type jsonStudent struct {
+	Peringkat int            `json:"peringkat"`
+	NIM       string         `json:"nim"`
+	Nama      string         `json:"nama"`
+	Jurusan   string         `json:"jurusan"`
+	Nilai     map[string]int `json:"nilai"`
+	RataRata  float64        `json:"rata_rata"` // Dibulatkan 2 desimal
+	Grade     string         `json:"grade"`
+}
+
+// jsonReport adalah bentuk JSON laporan lengkap
+type jsonReport struct {
+	MataKuliah []string      `json:"mata_kuliah"`
+	Mahasiswa  []jsonStudent `json:"mahasiswa"`
+}
+
+// WriteJSON menulis laporan sebagai JSON (misal untuk dikirim lewat API)
+func (g *Gradebook) WriteJSON(w io.Writer) error {
+	// Slice kosong (bukan nil) agar laporan tanpa data tetap ditulis [] di JSON
+	report := jsonReport{
+		MataKuliah: append([]string{}, g.Subjects()...),
+		Mahasiswa:  []jsonStudent{},
+	}
+	for _, entry := range g.Ranking() {
+		report.Mahasiswa = append(report.Mahasiswa, jsonStudent{
+			Peringkat: entry.Rank,
+			NIM:       entry.Student.NIM,
+			Nama:      entry.Student.Nama,
+			Jurusan:   entry.Student.Jurusan,
+			Nilai:     entry.Student.Nilai,
+			RataRata:  roundAverage(entry.Average),
+			Grade:     entry.Grade,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}